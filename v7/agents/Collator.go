@@ -15,10 +15,13 @@ package agents
 import (
 	fmt "fmt"
 	uti "github.com/craterdog/go-missing-utilities/v7"
+	mat "math"
+	big "math/big"
 	cmp "math/cmplx"
 	ref "reflect"
 	sts "strings"
 	syn "sync"
+	ato "sync/atomic"
 )
 
 // CLASS INTERFACE
@@ -300,6 +303,26 @@ func (v *collator_[V]) compareValues(
 
 func (v *collator_[V]) getType(
 	type_ ref.Type,
+) string {
+	var result, ok = (*typeNames_.Load())[type_]
+	if ok {
+		return result
+	}
+	result = v.nameType(type_)
+	numericMutex_.Lock()
+	var names = map[ref.Type]string{type_: result}
+	for key, name := range *typeNames_.Load() {
+		names[key] = name
+	}
+	typeNames_.Store(&names)
+	numericMutex_.Unlock()
+	return result
+}
+
+// This private method returns the name used to rank values of the specified
+// Go type against values of other types.
+func (v *collator_[V]) nameType(
+	type_ ref.Type,
 ) string {
 	var result = type_.String()
 	result = sts.TrimPrefix(result, "*")
@@ -340,6 +363,154 @@ func (v *collator_[V]) getType(
 	return result
 }
 
+// These private interfaces are used to recognize numeric values without any
+// reflection.  An exact numeric value has an intrinsic value that is a *big.Rat
+// and any other numeric value has a floating point value.
+type exact_ interface {
+	AsIntrinsic() *big.Rat
+}
+
+type floating_ interface {
+	AsFloat() float64
+}
+
+type dimensional_ interface {
+	GetDimensions() string
+}
+
+// This private method returns the position of the specified numeric value on
+// the extended real number line along with its finite value, if any:
+//
+//	0: the value is undefined
+//	1: the value is negative infinity
+//	2: the value is finite
+//	3: the value is positive infinity
+//
+// The finite value of an exact numeric value is returned as a rational number,
+// and that of any other numeric value as a floating point number.
+func (v *collator_[V]) numericValue(
+	value ref.Value,
+) (
+	order int,
+	rational *big.Rat,
+	float float64,
+) {
+	switch actual := value.Interface().(type) {
+	case exact_:
+		rational = actual.AsIntrinsic()
+		if rational != nil {
+			order = 2
+		}
+	case floating_:
+		float = actual.AsFloat()
+		switch {
+		case mat.IsNaN(float):
+			order = 0
+		case mat.IsInf(float, -1):
+			order = 1
+		case mat.IsInf(float, 1):
+			order = 3
+		default:
+			order = 2
+		}
+	}
+	return
+}
+
+// This private method determines whether or not the specified value is a
+// numeric value, meaning that it has either an exact or a floating point value.
+// Whether or not a Go type implements one of the numeric interfaces is cached
+// since checking it each time would slow down the ranking of all other values.
+func (v *collator_[V]) isNumeric(
+	value ref.Value,
+) bool {
+	switch value.Kind() {
+	case ref.Interface:
+		return !value.IsNil() && v.isNumeric(value.Elem())
+	case ref.Pointer:
+		if value.IsNil() {
+			return false
+		}
+	case ref.String, ref.Struct,
+		ref.Int8, ref.Int16, ref.Int32, ref.Int64, ref.Int,
+		ref.Uint8, ref.Uint16, ref.Uint32, ref.Uint64, ref.Uint,
+		ref.Float32, ref.Float64, ref.Complex64, ref.Complex128:
+	default:
+		return false
+	}
+	if value.NumMethod() == 0 || !value.CanInterface() {
+		return false
+	}
+	var type_ = value.Type()
+	var numeric, ok = (*numericTypes_.Load())[type_]
+	if !ok {
+		numeric = type_.Implements(exactType_) || type_.Implements(floatingType_)
+		numericMutex_.Lock()
+		var types = map[ref.Type]bool{type_: numeric}
+		for key, flag := range *numericTypes_.Load() {
+			types[key] = flag
+		}
+		numericTypes_.Store(&types)
+		numericMutex_.Unlock()
+	}
+	return numeric
+}
+
+// This private method returns the dimensions of the specified value if it is a
+// numeric value with dimensions (e.g. a quantity).
+func (v *collator_[V]) dimensionsOf(
+	value ref.Value,
+) (
+	dimensions string,
+	ok bool,
+) {
+	for value.Kind() == ref.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case ref.Struct:
+	case ref.Pointer:
+		if value.IsNil() {
+			return
+		}
+	default:
+		return
+	}
+	if !value.CanInterface() {
+		return
+	}
+	var dimensional dimensional_
+	dimensional, ok = value.Interface().(dimensional_)
+	if ok {
+		dimensions = dimensional.GetDimensions()
+	}
+	return
+}
+
+// This private constant is the type name used when ranking a numeric value
+// against a value that is not numeric.
+const numericType_ = "numeric"
+
+// These private variables are used to recognize the Go types of numeric values.
+var exactType_ = ref.TypeOf((*exact_)(nil)).Elem()
+var floatingType_ = ref.TypeOf((*floating_)(nil)).Elem()
+
+// NOTE:
+// The caches of numeric Go types and type names are replaced rather than
+// updated so that they can be read without any locking, since the set of Go
+// types is small and fixed.
+var numericMutex_ syn.Mutex
+var numericTypes_ = func() *ato.Pointer[map[ref.Type]bool] {
+	var types = new(ato.Pointer[map[ref.Type]bool])
+	types.Store(&map[ref.Type]bool{})
+	return types
+}()
+var typeNames_ = func() *ato.Pointer[map[ref.Type]string] {
+	var names = new(ato.Pointer[map[ref.Type]string])
+	names.Store(&map[ref.Type]string{})
+	return names
+}()
+
 func (v *collator_[V]) rankArrays(
 	first ref.Value,
 	second ref.Value,
@@ -580,6 +751,143 @@ func (v *collator_[V]) rankMaps(
 	return EqualRank
 }
 
+// NOTE:
+// Numeric values (those with an exact or floating point value) must be ranked
+// using a single total order, otherwise sorting and searching them would fail.
+// So all numeric values are ranked by their numeric values, even when their
+// types differ, and they are ranked against any other value as though they all
+// had the same type.  The only exception is a numeric value with dimensions
+// (e.g. a quantity) which is only ranked by its numeric value against another
+// value with the same dimensions.  Otherwise it is ranked using its type and
+// structure.  Undefined values are ranked before all other numeric values:
+//
+//	undefined < -∞ < finite values < +∞
+//
+// Exact numeric values (those whose intrinsic value is a *big.Rat) are ranked
+// exactly.  Two numeric values with the same numeric value are ranked by their
+// types, and then by their structures when their types are the same, so that
+// different values are never ranked as equal.
+func (v *collator_[V]) rankNumerics(
+	first ref.Value,
+	second ref.Value,
+) (
+	rank Rank,
+	ok bool,
+) {
+	var firstNumeric = v.isNumeric(first)
+	var secondNumeric = firstNumeric
+	if first.Kind() == ref.Interface || first.Type() != second.Type() {
+		// The second value has a different type so it must also be checked.
+		secondNumeric = v.isNumeric(second)
+	}
+	if !firstNumeric && !secondNumeric {
+		// The ranking of all other values remains unchanged.
+		return
+	}
+	return v.rankNumericValues(first, second, firstNumeric, secondNumeric)
+}
+
+// This private method ranks the specified values when at least one of them is
+// numeric.  It returns false if the values must be ranked as usual instead.
+func (v *collator_[V]) rankNumericValues(
+	first ref.Value,
+	second ref.Value,
+	firstNumeric bool,
+	secondNumeric bool,
+) (
+	rank Rank,
+	ok bool,
+) {
+	for first.Kind() == ref.Interface && !first.IsNil() {
+		first = first.Elem()
+	}
+	for second.Kind() == ref.Interface && !second.IsNil() {
+		second = second.Elem()
+	}
+	if first.Kind() == ref.Interface || second.Kind() == ref.Interface {
+		// A nil interface is ranked as usual.
+		return
+	}
+	var firstDimensions, secondDimensions string
+	var firstDimensional, secondDimensional bool
+	if firstNumeric {
+		firstDimensions, firstDimensional = v.dimensionsOf(first)
+		firstNumeric = !firstDimensional
+	}
+	if secondNumeric {
+		secondDimensions, secondDimensional = v.dimensionsOf(second)
+		secondNumeric = !secondDimensional
+	}
+	switch {
+	case firstDimensional && secondDimensional:
+		if firstDimensions != secondDimensions {
+			// Values with different dimensions are not comparable numerically.
+			return
+		}
+	case firstNumeric && secondNumeric:
+	case firstNumeric:
+		// The numeric values are ranked as though they had the same type.
+		rank = v.rankStrings(numericType_, v.getType(second.Type()))
+		ok = true
+		return
+	case secondNumeric:
+		// The numeric values are ranked as though they had the same type.
+		rank = v.rankStrings(v.getType(first.Type()), numericType_)
+		ok = true
+		return
+	default:
+		// A value with dimensions is ranked as usual against any other value.
+		return
+	}
+	rank = v.rankMagnitudes(first, second)
+	if rank == EqualRank {
+		var firstType = v.getType(first.Type())
+		var secondType = v.getType(second.Type())
+		if firstType == secondType {
+			// The values are ranked using their structures.
+			return
+		}
+		rank = v.rankStrings(firstType, secondType)
+	}
+	ok = true
+	return
+}
+
+// This private method ranks the specified numeric values by their numeric
+// values.
+func (v *collator_[V]) rankMagnitudes(
+	first ref.Value,
+	second ref.Value,
+) Rank {
+	var firstOrder, firstRational, firstFloat = v.numericValue(first)
+	var secondOrder, secondRational, secondFloat = v.numericValue(second)
+	switch {
+	case firstOrder < secondOrder:
+		return LesserRank
+	case firstOrder > secondOrder:
+		return GreaterRank
+	case firstOrder != 2:
+		// Both values are undefined or the same infinity.
+		return EqualRank
+	case firstRational == nil && secondRational == nil:
+		return v.rankFloats(firstFloat, secondFloat)
+	}
+	if firstRational == nil {
+		firstRational = new(big.Rat).SetFloat64(firstFloat)
+	}
+	if secondRational == nil {
+		secondRational = new(big.Rat).SetFloat64(secondFloat)
+	}
+	switch firstRational.Cmp(secondRational) {
+	case -1:
+		return LesserRank
+	case 1:
+		return GreaterRank
+	default:
+		return EqualRank
+	}
+}
+
 func (v *collator_[V]) rankIntrinsics(
 	first ref.Value,
 	second ref.Value,
@@ -728,6 +1036,11 @@ func (v *collator_[V]) rankValues(
 	}
 
 	// At this point, neither of the values are nil.
+	var rank, ok = v.rankNumerics(first, second)
+	if ok {
+		// The values were ranked by their numeric values.
+		return rank
+	}
 	var firstType = v.getType(first.Type())
 	var secondType = v.getType(second.Type())
 	if firstType != secondType && firstType != "any" && secondType != "any" {
@@ -14,6 +14,7 @@ package agents
 
 import (
 	b64 "encoding/base64"
+	bin "encoding/binary"
 	b16 "encoding/hex"
 	fmt "fmt"
 	mat "math"
@@ -146,12 +147,203 @@ func (v *encoder_) Base64Decode(
 	return bytes
 }
 
+func (v *encoder_) Encode(
+	value any,
+) []byte {
+	var class = encoderClass()
+	var bytes = class.encodeValue([]byte{}, value)
+	return bytes
+}
+
+func (v *encoder_) Decode(
+	bytes []byte,
+) (
+	value any,
+	err error,
+) {
+	var class = encoderClass()
+	var next int
+	value, next, err = class.decodeValue(bytes, 0)
+	if err == nil && next < len(bytes) {
+		value = nil
+		err = fmt.Errorf(
+			"The encoded value ends at byte %d but %d bytes were passed.",
+			next,
+			len(bytes),
+		)
+	}
+	return
+}
+
 // Attribute Methods
 
 // PROTECTED INTERFACE
 
 // Private Methods
 
+// These private constants define the type tags that the canonical binary form
+// reserves for the intrinsic field types.
+const (
+	booleanTag_ byte = 0x31 + iota
+	floatTag_
+	integerTag_
+	stringTag_
+)
+
+// This private class method appends the canonical binary form of the specified
+// value to the specified bytes.
+func (c *encoderClass_) encodeValue(
+	bytes []byte,
+	value any,
+) []byte {
+	switch actual := value.(type) {
+	case bool:
+		var boolean byte
+		if actual {
+			boolean = 1
+		}
+		bytes = append(bytes, booleanTag_, boolean)
+	case float64:
+		if mat.IsNaN(actual) {
+			actual = mat.NaN() // All undefined values share the same encoding.
+		}
+		bytes = append(bytes, floatTag_)
+		bytes = bin.BigEndian.AppendUint64(bytes, mat.Float64bits(actual))
+	case int:
+		bytes = append(bytes, integerTag_)
+		bytes = bin.AppendVarint(bytes, int64(actual))
+	case string:
+		bytes = append(bytes, stringTag_)
+		bytes = bin.AppendUvarint(bytes, uint64(len(actual)))
+		bytes = append(bytes, actual...)
+	case Tagged:
+		if actual.Tag >= booleanTag_ && actual.Tag <= stringTag_ {
+			var message = fmt.Sprintf(
+				"The type tag %d is reserved for the intrinsic types.",
+				actual.Tag,
+			)
+			panic(message)
+		}
+		bytes = append(bytes, actual.Tag)
+		bytes = bin.AppendUvarint(bytes, uint64(len(actual.Fields)))
+		for _, field := range actual.Fields {
+			bytes = c.encodeValue(bytes, field)
+		}
+	default:
+		var message = fmt.Sprintf(
+			"The encoder cannot encode a value of type %T: %v",
+			value,
+			value,
+		)
+		panic(message)
+	}
+	return bytes
+}
+
+// This private class method decodes the value whose canonical binary form
+// begins at the specified position in the encoded bytes.  It returns the value
+// and the position following its encoding.
+func (c *encoderClass_) decodeValue(
+	bytes []byte,
+	position int,
+) (
+	value any,
+	next int,
+	err error,
+) {
+	if position >= len(bytes) {
+		err = c.truncated(position)
+		return
+	}
+	var tag = bytes[position]
+	next = position + 1
+	switch tag {
+	case booleanTag_:
+		if next >= len(bytes) || bytes[next] > 1 {
+			err = fmt.Errorf("An invalid boolean was found at byte %d.", next)
+			return
+		}
+		value = bytes[next] == 1
+		next++
+	case floatTag_:
+		if next+8 > len(bytes) {
+			err = c.truncated(len(bytes))
+			return
+		}
+		var float = mat.Float64frombits(bin.BigEndian.Uint64(bytes[next : next+8]))
+		if mat.IsNaN(float) && mat.Float64bits(float) != mat.Float64bits(mat.NaN()) {
+			err = fmt.Errorf("A non-canonical undefined value was found at byte %d.", next)
+			return
+		}
+		value = float
+		next += 8
+	case integerTag_:
+		var integer, length = bin.Varint(bytes[next:])
+		if length <= 0 || length != len(bin.AppendVarint(nil, integer)) {
+			err = fmt.Errorf("An invalid integer was found at byte %d.", next)
+			return
+		}
+		value = int(integer)
+		next += length
+	case stringTag_:
+		var size int
+		size, next, err = c.decodeSize(bytes, next)
+		if err != nil {
+			return
+		}
+		value = string(bytes[next : next+size])
+		next += size
+	default:
+		var size int
+		size, next, err = c.decodeSize(bytes, next)
+		if err != nil {
+			return
+		}
+		var fields = make([]any, size)
+		for index := range fields {
+			fields[index], next, err = c.decodeValue(bytes, next)
+			if err != nil {
+				return
+			}
+		}
+		value = Tagged{Tag: tag, Fields: fields}
+	}
+	return
+}
+
+// This private class method decodes the unsigned variable length size at the
+// specified position in the encoded bytes.  The size cannot exceed the number
+// of bytes that remain since each field and string byte requires at least one.
+func (c *encoderClass_) decodeSize(
+	bytes []byte,
+	position int,
+) (
+	size int,
+	next int,
+	err error,
+) {
+	var value, length = bin.Uvarint(bytes[position:])
+	if length <= 0 || length != len(bin.AppendUvarint(nil, value)) {
+		err = fmt.Errorf("An invalid size was found at byte %d.", position)
+		return
+	}
+	if value > uint64(len(bytes)-position-length) {
+		err = c.truncated(len(bytes))
+		return
+	}
+	size = int(value)
+	next = position + length
+	return
+}
+
+// This private class method returns an error describing encoded bytes that end
+// prematurely at the specified position.
+func (c *encoderClass_) truncated(
+	position int,
+) error {
+	return fmt.Errorf("The encoded bytes end prematurely at byte %d.", position)
+}
+
 // This lookup table maps the base 32 characters to the corresponding base 32
 // digits. The letters 'E', 'I', 'O', and 'U' have been removed to avoid the
 // possibility of randomly occurring  offensive words.
@@ -0,0 +1,230 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package agents
+
+import (
+	fmt "fmt"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	reg "regexp"
+	rsx "regexp/syntax"
+	utf "unicode/utf8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func MatcherClass() MatcherClassLike {
+	return matcherClass()
+}
+
+// Constructor Methods
+
+func (c *matcherClass_) Matcher(
+	class string,
+	expression *reg.Regexp,
+) MatcherLike {
+	if uti.IsUndefined(class) {
+		panic("The \"class\" attribute is required by this class.")
+	}
+	if uti.IsUndefined(expression) {
+		panic("The \"expression\" attribute is required by this class.")
+	}
+	var instance = &matcher_{
+		// Initialize the instance attributes.
+		class_:      class,
+		expression_: expression,
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *matcher_) GetClass() MatcherClassLike {
+	return matcherClass()
+}
+
+func (v *matcher_) MatchSource(
+	source string,
+) (
+	matches []string,
+	err error,
+) {
+	// The match must start at the beginning of the source string and consume
+	// all of it.
+	var location = v.expression_.FindStringSubmatchIndex(source)
+	if location == nil || location[0] != 0 || location[1] != len(source) {
+		err = &ParseError{
+			Class:  v.class_,
+			Source: source,
+			Offset: v.failureOffset(source),
+		}
+		return
+	}
+
+	// Extract the submatches from their locations.
+	var count = len(location) / 2
+	matches = make([]string, count)
+	for index := 0; index < count; index++ {
+		var start = location[2*index]
+		var end = location[2*index+1]
+		if start >= 0 {
+			matches[index] = source[start:end]
+		}
+	}
+	return
+}
+
+// Attribute Methods
+
+func (v *matcher_) GetExpression() *reg.Regexp {
+	return v.expression_
+}
+
+// PROTECTED INTERFACE
+
+func (v *ParseError) Error() string {
+	var message = fmt.Sprintf(
+		"An illegal %s string was found at character %d: %s",
+		v.Class,
+		v.Offset,
+		v.Source,
+	)
+	return message
+}
+
+// Private Methods
+
+// This private method adds the specified instruction to a set of threads along
+// with every instruction that can be reached from it without consuming another
+// character.
+func (v *matcher_) addThread(
+	program *rsx.Prog,
+	threads map[uint32]bool,
+	pc uint32,
+	context rsx.EmptyOp,
+) {
+	if threads[pc] {
+		return
+	}
+	threads[pc] = true
+	var instruction = program.Inst[pc]
+	switch instruction.Op {
+	case rsx.InstAlt, rsx.InstAltMatch:
+		v.addThread(program, threads, instruction.Out, context)
+		v.addThread(program, threads, instruction.Arg, context)
+	case rsx.InstCapture, rsx.InstNop:
+		v.addThread(program, threads, instruction.Out, context)
+	case rsx.InstEmptyWidth:
+		if rsx.EmptyOp(instruction.Arg)&^context == 0 {
+			v.addThread(program, threads, instruction.Out, context)
+		}
+	}
+}
+
+// The Go regexp package does not report how far into a source string it got
+// before a match failed, so this private method simulates the nondeterministic
+// finite automaton for the regular expression one character at a time until
+// none of its threads can consume the next character.
+func (v *matcher_) failureOffset(source string) uint {
+	var syntax, _ = rsx.Parse(v.expression_.String(), rsx.Perl)
+	var program, _ = rsx.Compile(syntax.Simplify())
+
+	// Start all threads at the beginning of the source string.
+	var offset uint
+	var previous rune = -1
+	var next = v.runeAt(source)
+	var threads = map[uint32]bool{}
+	var context = rsx.EmptyOpContext(previous, next)
+	v.addThread(program, threads, uint32(program.Start), context)
+
+	// Advance the threads across each character of the source string.
+	for len(source) > 0 {
+		var current, size = utf.DecodeRuneInString(source)
+		source = source[size:]
+		next = v.runeAt(source)
+		context = rsx.EmptyOpContext(current, next)
+		var following = map[uint32]bool{}
+		for pc := range threads {
+			var instruction = &program.Inst[pc]
+			if v.matchesRune(instruction, current) {
+				v.addThread(program, following, instruction.Out, context)
+			}
+		}
+		if len(following) == 0 {
+			// No thread can consume the current character.
+			break
+		}
+		threads = following
+		offset++
+	}
+	return offset
+}
+
+// This private method determines whether or not the specified instruction
+// consumes the specified character.
+func (v *matcher_) matchesRune(
+	instruction *rsx.Inst,
+	character rune,
+) bool {
+	switch instruction.Op {
+	case rsx.InstRune, rsx.InstRune1:
+		return instruction.MatchRune(character)
+	case rsx.InstRuneAny:
+		return true
+	case rsx.InstRuneAnyNotNL:
+		return character != '\n'
+	default:
+		return false
+	}
+}
+
+// This private method returns the first character in the specified source
+// string, or -1 if the source string is empty.
+func (v *matcher_) runeAt(source string) rune {
+	if len(source) == 0 {
+		return -1
+	}
+	var character, _ = utf.DecodeRuneInString(source)
+	return character
+}
+
+// Instance Structure
+
+type matcher_ struct {
+	// Declare the instance attributes.
+	class_      string
+	expression_ *reg.Regexp
+}
+
+// Class Structure
+
+type matcherClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func matcherClass() *matcherClass_ {
+	return matcherClassReference_
+}
+
+var matcherClassReference_ = &matcherClass_{
+	// Initialize the class constants.
+}
@@ -29,7 +29,9 @@ on interfaces, not on each other.
 */
 package agents
 
-import ()
+import (
+	reg "regexp"
+)
 
 // TYPE DECLARATIONS
 
@@ -40,6 +42,18 @@ readable way.
 */
 type Event string
 
+/*
+ParseError is a structured error type that is returned whenever a source string
+cannot be parsed into a value of a specific class.  It reports the name of the
+class, the source string and the character offset within the source string at
+which the matching failed.
+*/
+type ParseError struct {
+	Class  string
+	Source string
+	Offset uint
+}
+
 /*
 Rank is a constrained type representing the possible rankings for two values.
 */
@@ -57,6 +71,19 @@ string type for a state makes it easier to print out in a human readable way.
 */
 type State string
 
+/*
+Tagged is a structured type representing a node in the tree of values that the
+Encode() method of an encoder translates into its canonical binary form.  Each
+node has a type tag that identifies the kind of value it represents and a
+sequence of fields, each of which is a bool, int, float64, string or another
+Tagged node.  The type tags 0x31 through 0x34 are reserved by the encoder for
+the intrinsic field types.
+*/
+type Tagged struct {
+	Tag    byte
+	Fields []any
+}
+
 /*
 Transitions is a constrained type representing a row of states in a state machine.
 */
@@ -144,6 +171,15 @@ following:
   - Base 16 [0-9][a-f]
   - Base 32 [0-9][A-D][F-H][J-N][P-T][V-Z]  {excludes "EIOU"}
   - Base 64 [0-9][A-Z][a-z][+/]
+  - A canonical binary form for trees of intrinsic values and Tagged nodes
+
+In the canonical binary form each value is a type tag followed by its payload.
+A bool is a single byte (0 or 1), an int is a signed variable length integer, a
+float64 is eight big-endian bytes (with all undefined values sharing the same
+bytes) and a string is a variable length byte count followed by its bytes.  A
+Tagged node is its own type tag followed by a variable length field count and
+its fields.  Each value therefore has exactly one encoding, so the encoded bytes
+may be hashed, signed and compared.
 */
 type EncoderClassLike interface {
 	// Constructor Methods
@@ -193,6 +229,24 @@ type IteratorClassLike[V any] interface {
 	) IteratorLike[V]
 }
 
+/*
+MatcherClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+matcher-like class.
+
+A matcher-like class matches source strings against the regular expression for
+a specific class.  Unlike the Go regexp package, a matcher requires that the
+entire source string be matched, and when it is not, it reports the character
+offset at which the matching failed using a ParseError.
+*/
+type MatcherClassLike interface {
+	// Constructor Methods
+	Matcher(
+		class string,
+		expression *reg.Regexp,
+	) MatcherLike
+}
+
 /*
 SorterClassLike[V any] is a class interface that declares the complete set
 of class constructors, constants and functions that must be supported by each
@@ -280,6 +334,15 @@ type EncoderLike interface {
 	Base64Decode(
 		encoded string,
 	) []byte
+	Encode(
+		value any,
+	) []byte
+	Decode(
+		bytes []byte,
+	) (
+		value any,
+		err error,
+	)
 }
 
 /*
@@ -324,6 +387,25 @@ type IteratorLike[V any] interface {
 	)
 }
 
+/*
+MatcherLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each
+instance of a concrete matcher-like class.
+*/
+type MatcherLike interface {
+	// Principal Methods
+	GetClass() MatcherClassLike
+	MatchSource(
+		source string,
+	) (
+		matches []string,
+		err error,
+	)
+
+	// Attribute Methods
+	GetExpression() *reg.Regexp
+}
+
 /*
 SorterLike[V any] is an instance interface that declares the complete set of
 principal, attribute and aspect methods that must be supported by each
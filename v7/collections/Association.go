@@ -22,7 +22,7 @@ import (
 
 // Access Function
 
-func AssociationClass[K comparable, V any]() AssociationClassLike[K, V] {
+func AssociationClass[K any, V any]() AssociationClassLike[K, V] {
 	return associationClass[K, V]()
 }
 
@@ -80,17 +80,56 @@ func (v *association_[K, V]) SetValue(
 // PROTECTED INTERFACE
 
 func (v *association_[K, V]) String() string {
-	var result = uti.Format(v.GetKey())
+	var class = collectionParserClass()
+	var result = class.formatValue(v.GetKey())
 	result += ": "
-	result += uti.Format(v.GetValue())
+	result += class.formatValue(v.GetValue())
 	return result
 }
 
+func (v *association_[K, V]) MarshalJSON() ([]byte, error) {
+	var class = collectionParserClass()
+	var member, err = class.encodeMember(v.key_, v.value_)
+	if err != nil {
+		return nil, err
+	}
+	var bytes = []byte{'{'}
+	bytes = append(bytes, member...)
+	bytes = append(bytes, '}')
+	return bytes, nil
+}
+
+func (v *association_[K, V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var class = collectionParserClass()
+	var names, members, err = class.decodeMembers(bytes)
+	if err != nil {
+		return err
+	}
+	if len(names) != 1 {
+		return fmt.Errorf("A JSON association must have exactly one member: %s", bytes)
+	}
+	var key K
+	err = class.decodeKey(names[0], &key)
+	if err != nil {
+		return err
+	}
+	var value V
+	err = class.decodeValue(members[0], &value)
+	if err != nil {
+		return err
+	}
+	v.key_ = key
+	v.value_ = value
+	return nil
+}
+
 // Private Methods
 
 // Instance Structure
 
-type association_[K comparable, V any] struct {
+type association_[K any, V any] struct {
 	// Declare the instance attributes.
 	key_   K
 	value_ V
@@ -98,7 +137,7 @@ type association_[K comparable, V any] struct {
 
 // Class Structure
 
-type associationClass_[K comparable, V any] struct {
+type associationClass_[K any, V any] struct {
 	// Declare the class constants.
 }
 
@@ -107,7 +146,7 @@ type associationClass_[K comparable, V any] struct {
 var associationMap_ = map[string]any{}
 var associationMutex_ syn.Mutex
 
-func associationClass[K comparable, V any]() *associationClass_[K, V] {
+func associationClass[K any, V any]() *associationClass_[K, V] {
 	// Generate the name of the bound class type.
 	var class *associationClass_[K, V]
 	var name = fmt.Sprintf("%T", class)
@@ -0,0 +1,399 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func BagClass[V any]() BagClassLike[V] {
+	return bagClass[V]()
+}
+
+// Constructor Methods
+
+func (c *bagClass_[V]) Bag() BagLike[V] {
+	var collatorClass = age.CollatorClass[V]()
+	var collator = collatorClass.Collator()
+	var instance = c.BagWithCollator(collator)
+	return instance
+}
+
+func (c *bagClass_[V]) BagWithCollator(
+	collator age.CollatorLike[V],
+) BagLike[V] {
+	if uti.IsUndefined(collator) {
+		panic("The \"collator\" attribute is required by this class.")
+	}
+	var instance = &bag_[V]{
+		// Initialize the instance attributes.
+		collator_: collator,
+	}
+	return instance
+}
+
+func (c *bagClass_[V]) BagFromArray(
+	values []V,
+) BagLike[V] {
+	var bag = c.Bag()
+	for _, value := range values {
+		bag.AddValue(value)
+	}
+	return bag
+}
+
+func (c *bagClass_[V]) BagFromSequence(
+	values str.Sequential[V],
+) BagLike[V] {
+	var bag = c.Bag()
+	bag.AddValues(values)
+	return bag
+}
+
+func (c *bagClass_[V]) BagFromString(
+	source string,
+) BagLike[V] {
+	var values = collectionParserClass().parseSequence(source, "bag")
+	var bag = c.Bag()
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value, ok = iterator.GetNext().(V)
+		if !ok {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the bag constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		bag.AddValue(value)
+	}
+	return bag
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *bagClass_[V]) And(
+	first BagLike[V],
+	second BagLike[V],
+) BagLike[V] {
+	var result = c.BagWithCollator(first.GetCollator())
+	var iterator = first.GetDistinct().GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		var count = min(first.GetCount(value), second.GetCount(value))
+		result.SetCount(value, count)
+	}
+	return result
+}
+
+func (c *bagClass_[V]) Ior(
+	first BagLike[V],
+	second BagLike[V],
+) BagLike[V] {
+	var result = c.BagWithCollator(first.GetCollator())
+	result.AddValues(first)
+	var iterator = second.GetDistinct().GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		var count = max(first.GetCount(value), second.GetCount(value))
+		result.SetCount(value, count)
+	}
+	return result
+}
+
+func (c *bagClass_[V]) San(
+	first BagLike[V],
+	second BagLike[V],
+) BagLike[V] {
+	var result = c.BagWithCollator(first.GetCollator())
+	result.AddValues(first)
+	result.RemoveValues(second)
+	return result
+}
+
+func (c *bagClass_[V]) Sum(
+	first BagLike[V],
+	second BagLike[V],
+) BagLike[V] {
+	var result = c.BagWithCollator(first.GetCollator())
+	result.AddValues(first)
+	result.AddValues(second)
+	return result
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *bag_[V]) GetClass() BagClassLike[V] {
+	return bagClass[V]()
+}
+
+func (v *bag_[V]) GetCount(
+	value V,
+) uint {
+	var slot, found = v.root_.findSlot(v.ranker(value))
+	if !found {
+		return 0
+	}
+	return v.root_.getValue(slot).count_
+}
+
+func (v *bag_[V]) SetCount(
+	value V,
+	count uint,
+) {
+	var slot, found = v.root_.findSlot(v.ranker(value))
+	switch {
+	case found && count == 0:
+		// Remove all copies of the value.
+		v.size_ -= v.root_.getValue(slot).count_
+		v.root_ = v.root_.removeValue(slot)
+	case found:
+		// Change the number of copies of the value.
+		var entry = v.root_.getValue(slot)
+		v.size_ = v.size_ - entry.count_ + count
+		entry.count_ = count
+		v.root_ = v.root_.setValue(slot, entry)
+	case count > 0:
+		// Add the first copies of the value.
+		var entry = bagEntry_[V]{value_: value, count_: count}
+		v.size_ += count
+		v.root_ = v.root_.insertValue(slot, entry)
+	}
+}
+
+func (v *bag_[V]) GetDistinct() str.Sequential[V] {
+	var listClass = ListClass[V]()
+	var values = listClass.List()
+	for _, entry := range v.root_.appendValues(nil) {
+		values.AppendValue(entry.value_)
+	}
+	return values
+}
+
+// Attribute Methods
+
+func (v *bag_[V]) GetCollator() age.CollatorLike[V] {
+	return v.collator_
+}
+
+// Elastic[V] Methods
+
+func (v *bag_[V]) AddValue(
+	value V,
+) {
+	v.SetCount(value, v.GetCount(value)+1)
+}
+
+func (v *bag_[V]) AddValues(
+	values str.Sequential[V],
+) {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		v.AddValue(value)
+	}
+}
+
+func (v *bag_[V]) RemoveValue(
+	value V,
+) {
+	var count = v.GetCount(value)
+	if count > 0 {
+		// Remove a single copy of the value.
+		v.SetCount(value, count-1)
+	}
+}
+
+func (v *bag_[V]) RemoveValues(
+	values str.Sequential[V],
+) {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		v.RemoveValue(value)
+	}
+}
+
+func (v *bag_[V]) RemoveAll() {
+	v.root_ = nil
+	v.size_ = 0
+}
+
+// str.Searchable[V] Methods
+
+func (v *bag_[V]) ContainsValue(
+	value V,
+) bool {
+	var _, found = v.root_.findSlot(v.ranker(value))
+	return found
+}
+
+func (v *bag_[V]) ContainsAny(
+	values str.Sequential[V],
+) bool {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		if v.ContainsValue(value) {
+			// This bag contains at least one of the values.
+			return true
+		}
+	}
+	// This bag does not contain any of the values.
+	return false
+}
+
+func (v *bag_[V]) ContainsAll(
+	values str.Sequential[V],
+) bool {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		if !v.ContainsValue(value) {
+			// This bag is missing at least one of the values.
+			return false
+		}
+	}
+	// This bag does contains all of the values.
+	return true
+}
+
+// str.Sequential[V] Methods
+
+func (v *bag_[V]) IsEmpty() bool {
+	return v.root_ == nil
+}
+
+func (v *bag_[V]) GetSize() uint {
+	return v.size_
+}
+
+func (v *bag_[V]) AsArray() []V {
+	// Each value appears in the array once for each of its copies.
+	var array = make([]V, 0, v.size_)
+	for _, entry := range v.root_.appendValues(nil) {
+		for range entry.count_ {
+			array = append(array, entry.value_)
+		}
+	}
+	return array
+}
+
+func (v *bag_[V]) GetIterator() age.IteratorLike[V] {
+	var iteratorClass = age.IteratorClass[V]()
+	var iterator = iteratorClass.Iterator(v.AsArray())
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *bag_[V]) String() string {
+	return fmt.Sprintf("%v", ListClass[V]().ListFromArray(v.AsArray()))
+}
+
+func (v *bag_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsArray())
+}
+
+func (v *bag_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var values = ListClass[V]().List()
+	var err = jsn.Unmarshal(bytes, values)
+	if err != nil {
+		return err
+	}
+	if uti.IsUndefined(v.collator_) {
+		v.collator_ = age.CollatorClass[V]().Collator()
+	}
+	v.RemoveAll()
+	v.AddValues(values)
+	return nil
+}
+
+// Private Methods
+
+// This private instance method returns a function that ranks the specified
+// value against the value of a candidate entry in the bag.
+func (v *bag_[V]) ranker(
+	value V,
+) func(candidate bagEntry_[V]) age.Rank {
+	return func(candidate bagEntry_[V]) age.Rank {
+		return v.collator_.RankValues(value, candidate.value_)
+	}
+}
+
+// This private type defines an entry in a bag which holds a distinct value and
+// the number of copies of that value that are in the bag.
+type bagEntry_[V any] struct {
+	value_ V
+	count_ uint
+}
+
+// Instance Structure
+
+type bag_[V any] struct {
+	// Declare the instance attributes.
+	collator_ age.CollatorLike[V]
+	root_     *node_[bagEntry_[V]]
+	size_     uint
+}
+
+// Class Structure
+
+type bagClass_[V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var bagMap_ = map[string]any{}
+var bagMutex_ syn.Mutex
+
+func bagClass[V any]() *bagClass_[V] {
+	// Generate the name of the bound class type.
+	var class *bagClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	bagMutex_.Lock()
+	var value = bagMap_[name]
+	switch actual := value.(type) {
+	case *bagClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &bagClass_[V]{
+			// Initialize the class constants.
+		}
+		bagMap_[name] = class
+	}
+	bagMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
@@ -0,0 +1,201 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	ctx "context"
+	fmt "fmt"
+	ele "github.com/craterdog/go-component-framework/v7/elements"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	syn "sync"
+	tim "time"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func BatcherClass[V any]() BatcherClassLike[V] {
+	return batcherClass[V]()
+}
+
+// Constructor Methods
+
+// Constant Methods
+
+// Function Methods
+
+func (c *batcherClass_[V]) Batch(
+	group Synchronized,
+	input QueueLike[V],
+	size uint,
+) QueueLike[ListLike[V]] {
+	// Validate the arguments.
+	if size < 1 {
+		panic("The size of a batch must be greater than zero.")
+	}
+
+	// Create the new output queue.
+	var outputClass = QueueClass[ListLike[V]]()
+	var output = outputClass.QueueWithCapacity(input.GetCapacity())
+	groupClass().connectChannels(group, output)
+	var context = groupClass().contextOf(group)
+
+	// Connect up the input queue to the output queue.
+	group.Go(func() {
+		// Write each batch of values read from the input queue to the output queue.
+		var listClass = ListClass[V]()
+		var batch = listClass.List()
+		for {
+			var value, ok = queueClass[V]().removeValue(context, input) // Will block when empty.
+			if !ok {
+				break // The input queue has been closed or the group torn down.
+			}
+			batch.AppendValue(value)
+			if batch.GetSize() == size {
+				if c.addValue(output, batch) == Closed {
+					// The output queue has been closed so tear down the batcher.
+					queueClass[V]().closeInputs(context, input)
+				}
+				batch = listClass.List()
+			}
+		}
+
+		// Write any partial batch and close the output queue.
+		if !batch.IsEmpty() {
+			c.addValue(output, batch)
+		}
+		output.CloseChannel()
+	})
+
+	return output
+}
+
+func (c *batcherClass_[V]) Window(
+	group Synchronized,
+	input QueueLike[V],
+	duration ele.DurationLike,
+) QueueLike[ListLike[V]] {
+	// Validate the arguments.
+	if uti.IsUndefined(duration) || duration.AsIntrinsic() < 1 {
+		panic("The duration of a window must be greater than zero.")
+	}
+
+	// Create the new output queue.
+	var outputClass = QueueClass[ListLike[V]]()
+	var output = outputClass.QueueWithCapacity(input.GetCapacity())
+	groupClass().connectChannels(group, output)
+	var context = groupClass().contextOf(group)
+
+	// Connect up the input queue to the output queue.
+	group.Go(func() {
+		var listClass = ListClass[V]()
+		var length = tim.Duration(duration.AsIntrinsic()) * tim.Millisecond
+		for {
+			// Start a new window when the next value is read from the input queue.
+			var value, ok = queueClass[V]().removeValue(context, input) // Will block when empty.
+			if !ok {
+				break // The input queue has been closed or the group torn down.
+			}
+			var batch = listClass.List()
+			batch.AppendValue(value)
+
+			// Read the values from the input queue until the window ends.
+			var window, cancel = ctx.WithTimeout(context, length)
+			var status Status
+			for {
+				value, status = input.RemoveFirstWithContext(window)
+				if status != Succeeded {
+					break // The window has ended.
+				}
+				batch.AppendValue(value)
+			}
+			cancel()
+
+			// Write the batch of values read during the window to the output queue.
+			if c.addValue(output, batch) == Closed {
+				// The output queue has been closed so tear down the window.
+				queueClass[V]().closeInputs(context, input)
+			}
+			if status == Closed || status == Cancelled {
+				break // The input queue has been closed or the group torn down.
+			}
+		}
+
+		// Close the output queue.
+		output.CloseChannel()
+	})
+
+	return output
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+// Attribute Methods
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// This private class method adds the specified batch of values to the specified
+// output queue.  It returns the status of the addition.
+func (c *batcherClass_[V]) addValue(
+	output QueueLike[ListLike[V]],
+	batch ListLike[V],
+) Status {
+	return output.AddValueWithContext(ctx.Background(), batch) // Will block when full.
+}
+
+// Instance Structure
+
+// Class Structure
+
+// NOTE:
+// These class functions cannot be declared by the queue class itself since a
+// queue class function returning a queue of lists would require the Go compiler
+// to instantiate queues of lists of lists, and so on, without end.
+type batcherClass_[V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var batcherMap_ = map[string]any{}
+var batcherMutex_ syn.Mutex
+
+func batcherClass[V any]() *batcherClass_[V] {
+	// Generate the name of the bound class type.
+	var class *batcherClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	batcherMutex_.Lock()
+	var value = batcherMap_[name]
+	switch actual := value.(type) {
+	case *batcherClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &batcherClass_[V]{
+			// Initialize the class constants.
+		}
+		batcherMap_[name] = class
+	}
+	batcherMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
@@ -16,7 +16,6 @@ import (
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	str "github.com/craterdog/go-component-framework/v7/strings"
-	uti "github.com/craterdog/go-missing-utilities/v7"
 	syn "sync"
 )
 
@@ -84,6 +83,28 @@ func (c *catalogClass_[K, V]) CatalogFromSequence(
 	return catalog
 }
 
+func (c *catalogClass_[K, V]) CatalogFromString(
+	source string,
+) CatalogLike[K, V] {
+	var associations = collectionParserClass().parseCatalog(source)
+	var catalog = c.Catalog()
+	var iterator = associations.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var key, keyOk = association.GetKey().(K)
+		var value, valueOk = association.GetValue().(V)
+		if !keyOk || !valueOk {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the catalog constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		catalog.SetValue(key, value)
+	}
+	return catalog
+}
+
 // Constant Methods
 
 // Function Methods
@@ -270,7 +291,61 @@ func (v *catalog_[K, V]) ShuffleValues() {
 // PROTECTED INTERFACE
 
 func (v *catalog_[K, V]) String() string {
-	return uti.Format(v)
+	if v.associations_.IsEmpty() {
+		return "[:]"
+	}
+	return fmt.Sprintf("%v", v.associations_)
+}
+
+func (v *catalog_[K, V]) MarshalJSON() ([]byte, error) {
+	// The associations are written out one at a time since the intrinsic Go map
+	// data type does not preserve their order.
+	var class = collectionParserClass()
+	var bytes = []byte{'{'}
+	var iterator = v.associations_.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var member, err = class.encodeMember(
+			association.GetKey(),
+			association.GetValue(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if iterator.GetSlot() > 1 {
+			bytes = append(bytes, ',')
+		}
+		bytes = append(bytes, member...)
+	}
+	bytes = append(bytes, '}')
+	return bytes, nil
+}
+
+func (v *catalog_[K, V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var class = collectionParserClass()
+	var names, members, err = class.decodeMembers(bytes)
+	if err != nil {
+		return err
+	}
+	var keys = make([]K, len(names))
+	var values = make([]V, len(members))
+	for index, name := range names {
+		err = class.decodeKey(name, &keys[index])
+		if err != nil {
+			return err
+		}
+		err = class.decodeValue(members[index], &values[index])
+		if err != nil {
+			return err
+		}
+	}
+	v.RemoveAll()
+	for index, key := range keys {
+		v.SetValue(key, values[index])
+	}
+	return nil
 }
 
 // Private Methods
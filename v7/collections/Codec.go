@@ -0,0 +1,525 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	ele "github.com/craterdog/go-component-framework/v7/elements"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	ref "reflect"
+	tim "time"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func CodecClass() CodecClassLike {
+	return codecClass()
+}
+
+// Constructor Methods
+
+func (c *codecClass_) Codec() CodecLike {
+	var instance = &codec_{
+		// Initialize the instance attributes.
+		encoder_: age.EncoderClass().Encoder(),
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *codec_) GetClass() CodecClassLike {
+	return codecClass()
+}
+
+func (v *codec_) Encode(
+	value any,
+) []byte {
+	var class = codecClass()
+	var bytes = v.encoder_.Encode(class.encodeValue(value))
+	return bytes
+}
+
+func (v *codec_) Decode(
+	bytes []byte,
+) (
+	value any,
+	err error,
+) {
+	var class = codecClass()
+	var encoded any
+	encoded, err = v.encoder_.Decode(bytes)
+	if err == nil {
+		value, err = class.decodeValue(encoded)
+	}
+	if err == nil && string(v.Encode(value)) != string(bytes) {
+		// Otherwise different bytes could decode to the same value (e.g. a set
+		// whose values are out of order).
+		value = nil
+		err = fmt.Errorf("The encoded bytes are not in their canonical form.")
+	}
+	return
+}
+
+// Attribute Methods
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// These private constants define the type tag that identifies each kind of
+// encoded value.  The intrinsic Go types are tagged by the encoder itself.
+const (
+	angleTag_ byte = 0x01 + iota
+	booleanTag_
+	durationTag_
+	glyphTag_
+	momentTag_
+	numberTag_
+	percentageTag_
+	probabilityTag_
+	resourceTag_
+	symbolTag_
+	instantTag_
+	spanTag_
+	decimalTag_
+	rationalTag_
+	quantityTag_
+	moneyTag_
+)
+
+const (
+	binaryTag_ byte = 0x11 + iota
+	nameTag_
+	narrativeTag_
+	patternTag_
+	quoteTag_
+	tagTag_
+	versionTag_
+)
+
+const (
+	associationTag_ byte = 0x21 + iota
+	catalogTag_
+	listTag_
+	queueTag_
+	setTag_
+	stackTag_
+	bagTag_
+)
+
+// This private class method returns the tagged node containing the encoded
+// values returned by the AsArray() method of the specified sequence.
+func (c *codecClass_) encodeValues(
+	tag byte,
+	sequence ref.Value,
+) age.Tagged {
+	var array = sequence.MethodByName("AsArray").Call([]ref.Value{})[0]
+	var fields = make([]any, array.Len())
+	for index := range fields {
+		fields[index] = c.encodeValue(array.Index(index).Interface())
+	}
+	return age.Tagged{Tag: tag, Fields: fields}
+}
+
+// This private class method returns the tagged node for the specified
+// collection.  The kind of collection is determined from the methods that it
+// supports since its generic type is not known.
+func (c *codecClass_) encodeCollection(
+	collection any,
+) age.Tagged {
+	var reflected = ref.ValueOf(collection)
+	switch {
+	case reflected.MethodByName("AsCatalog").IsValid():
+		var catalog = reflected.MethodByName("AsCatalog").Call([]ref.Value{})[0]
+		return c.encodeCollection(catalog.Interface())
+	case reflected.MethodByName("GetKey").IsValid():
+		var key = reflected.MethodByName("GetKey").Call([]ref.Value{})[0]
+		var value = reflected.MethodByName("GetValue").Call([]ref.Value{})[0]
+		return age.Tagged{
+			Tag: associationTag_,
+			Fields: []any{
+				c.encodeValue(key.Interface()),
+				c.encodeValue(value.Interface()),
+			},
+		}
+	case reflected.MethodByName("GetKeys").IsValid():
+		// The keys and values are interleaved in insertion order.
+		var array = reflected.MethodByName("AsArray").Call([]ref.Value{})[0]
+		var fields = make([]any, 0, 2*array.Len())
+		for index := 0; index < array.Len(); index++ {
+			var association = array.Index(index)
+			var key = association.MethodByName("GetKey").Call([]ref.Value{})[0]
+			var value = association.MethodByName("GetValue").Call([]ref.Value{})[0]
+			fields = append(
+				fields,
+				c.encodeValue(key.Interface()),
+				c.encodeValue(value.Interface()),
+			)
+		}
+		return age.Tagged{Tag: catalogTag_, Fields: fields}
+	case reflected.MethodByName("CloseChannel").IsValid():
+		return c.encodeValues(queueTag_, reflected)
+	case reflected.MethodByName("GetCount").IsValid():
+		return c.encodeValues(bagTag_, reflected)
+	case reflected.MethodByName("GetCollator").IsValid():
+		return c.encodeValues(setTag_, reflected)
+	case reflected.MethodByName("RemoveLast").IsValid():
+		return c.encodeValues(stackTag_, reflected)
+	case reflected.MethodByName("AsArray").IsValid():
+		return c.encodeValues(listTag_, reflected)
+	default:
+		var message = fmt.Sprintf(
+			"The codec cannot encode a value of type %T: %v",
+			collection,
+			collection,
+		)
+		panic(message)
+	}
+}
+
+// This private class method returns the value that the agents encoder converts
+// into the canonical binary form of the specified value.  Intrinsic values are
+// returned as they are and all other values are returned as tagged nodes.
+func (c *codecClass_) encodeValue(
+	value any,
+) any {
+	var tagged = func(tag byte, fields ...any) age.Tagged {
+		return age.Tagged{Tag: tag, Fields: fields}
+	}
+	switch actual := value.(type) {
+	// Elements
+	case ele.AngleLike:
+		return tagged(angleTag_, actual.AsIntrinsic())
+	case ele.BooleanLike:
+		return tagged(booleanTag_, actual.AsIntrinsic())
+	case ele.DecimalLike:
+		return tagged(decimalTag_, actual.AsString())
+	case ele.DurationLike:
+		return tagged(durationTag_, actual.AsInteger())
+	case ele.InstantLike:
+		var time = actual.AsTime()
+		return tagged(instantTag_, int(time.Unix()), time.Nanosecond())
+	case ele.GlyphLike:
+		return tagged(glyphTag_, int(actual.AsIntrinsic()))
+	case ele.MomentLike:
+		return tagged(momentTag_, actual.AsIntrinsic())
+	case ele.NumberLike:
+		return tagged(numberTag_, actual.GetReal(), actual.GetImaginary())
+	case ele.PercentageLike:
+		return tagged(percentageTag_, actual.AsFloat()) // As a percentage.
+	case ele.ProbabilityLike:
+		return tagged(probabilityTag_, actual.AsIntrinsic())
+	case ele.MoneyLike:
+		return tagged(moneyTag_, actual.AsString())
+	case ele.QuantityLike:
+		return tagged(quantityTag_, actual.AsString())
+	case ele.RationalLike:
+		return tagged(rationalTag_, actual.AsString())
+	case ele.ResourceLike:
+		return tagged(resourceTag_, actual.AsIntrinsic())
+	case ele.SpanLike:
+		return tagged(spanTag_, int(actual.AsIntrinsic()))
+	case ele.SymbolLike:
+		return tagged(symbolTag_, actual.AsIntrinsic())
+
+	// Strings
+	case str.BinaryLike:
+		return tagged(binaryTag_, string(actual.AsIntrinsic()))
+	case str.NameLike:
+		return tagged(nameTag_, actual.AsString())
+	case str.NarrativeLike:
+		return tagged(narrativeTag_, actual.AsString())
+	case str.PatternLike:
+		return tagged(patternTag_, actual.AsString())
+	case str.QuoteLike:
+		return tagged(quoteTag_, actual.AsString())
+	case str.TagLike:
+		return tagged(tagTag_, actual.AsString())
+	case str.VersionLike:
+		return tagged(versionTag_, actual.AsString())
+
+	// Intrinsics
+	case bool, float64, int, string:
+		return actual
+
+	// Collections
+	default:
+		return c.encodeCollection(value)
+	}
+}
+
+// This private class method checks that the fields of the specified tagged
+// node have the same types as the specified prototypes.
+func (c *codecClass_) checkFields(
+	node age.Tagged,
+	prototypes ...any,
+) error {
+	var valid = len(node.Fields) == len(prototypes)
+	for index := 0; valid && index < len(prototypes); index++ {
+		valid = ref.TypeOf(node.Fields[index]) == ref.TypeOf(prototypes[index])
+	}
+	if !valid {
+		return fmt.Errorf("The encoded value with type tag %d is invalid.", node.Tag)
+	}
+	return nil
+}
+
+// This private class method decodes the values contained in the fields of the
+// specified tagged node.
+func (c *codecClass_) decodeValues(
+	node age.Tagged,
+) (
+	values []any,
+	err error,
+) {
+	values = make([]any, len(node.Fields))
+	for index, field := range node.Fields {
+		values[index], err = c.decodeValue(field)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// This private class method decodes the value that the agents encoder decoded
+// from the canonical binary form.  Collections are decoded as collections of
+// type any.
+func (c *codecClass_) decodeValue(
+	encoded any,
+) (
+	value any,
+	err error,
+) {
+	var node, ok = encoded.(age.Tagged)
+	if !ok {
+		// Intrinsic values are decoded by the encoder itself.
+		value = encoded
+		return
+	}
+	var fields = node.Fields
+	var values []any
+	switch node.Tag {
+	// Elements
+	case angleTag_:
+		err = c.checkFields(node, 0.0)
+		if err == nil {
+			value = ele.AngleClass().Angle(fields[0].(float64))
+		}
+	case booleanTag_:
+		err = c.checkFields(node, false)
+		if err == nil {
+			value = ele.BooleanClass().Boolean(fields[0].(bool))
+		}
+	case decimalTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value, err = ele.DecimalClass().ParseDecimal(fields[0].(string))
+		}
+	case durationTag_:
+		err = c.checkFields(node, 0)
+		if err == nil {
+			value = ele.DurationClass().Duration(fields[0].(int))
+		}
+	case instantTag_:
+		err = c.checkFields(node, 0, 0)
+		if err == nil {
+			var nanoseconds = fields[1].(int)
+			if nanoseconds < 0 || nanoseconds >= 1e9 {
+				err = fmt.Errorf("An invalid instant was encoded: %v", fields)
+				return
+			}
+			value = ele.InstantClass().Instant(fields[0].(int), nanoseconds)
+		}
+	case glyphTag_:
+		err = c.checkFields(node, 0)
+		if err == nil {
+			value = ele.GlyphClass().Glyph(rune(fields[0].(int)))
+		}
+	case momentTag_:
+		err = c.checkFields(node, 0)
+		if err == nil {
+			value = ele.MomentClass().Moment(fields[0].(int))
+		}
+	case numberTag_:
+		err = c.checkFields(node, 0.0, 0.0)
+		if err == nil {
+			var real, imaginary = fields[0].(float64), fields[1].(float64)
+			value = ele.NumberClass().Number(complex(real, imaginary))
+		}
+	case percentageTag_:
+		err = c.checkFields(node, 0.0)
+		if err == nil {
+			value = ele.PercentageClass().Percentage(fields[0].(float64))
+		}
+	case probabilityTag_:
+		err = c.checkFields(node, 0.0)
+		if err == nil {
+			var float = fields[0].(float64)
+			if !(float >= 0 && float <= 1) {
+				err = fmt.Errorf("An invalid probability was encoded: %v", float)
+				return
+			}
+			value = ele.ProbabilityClass().Probability(float)
+		}
+	case moneyTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value, err = ele.MoneyClass().ParseMoney(fields[0].(string))
+		}
+	case quantityTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value, err = ele.QuantityClass().ParseQuantity(fields[0].(string))
+		}
+	case rationalTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value, err = ele.RationalClass().ParseRational(fields[0].(string))
+		}
+	case resourceTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value, err = ele.ResourceClass().ParseResource("<" + fields[0].(string) + ">")
+		}
+	case spanTag_:
+		err = c.checkFields(node, 0)
+		if err == nil {
+			value = ele.SpanClass().Span(tim.Duration(fields[0].(int)))
+		}
+	case symbolTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value, err = ele.SymbolClass().ParseSymbol("$" + fields[0].(string))
+		}
+
+	// Strings
+	case binaryTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value = str.BinaryClass().Binary([]byte(fields[0].(string)))
+		}
+	case nameTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value, err = str.NameClass().ParseName(fields[0].(string))
+		}
+	case narrativeTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value, err = str.NarrativeClass().ParseNarrative(fields[0].(string))
+		}
+	case patternTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value, err = str.PatternClass().ParsePattern(fields[0].(string))
+		}
+	case quoteTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value, err = str.QuoteClass().ParseQuote(fields[0].(string))
+		}
+	case tagTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value, err = str.TagClass().ParseTag(fields[0].(string))
+		}
+	case versionTag_:
+		err = c.checkFields(node, "")
+		if err == nil {
+			value, err = str.VersionClass().ParseVersion(fields[0].(string))
+		}
+
+	// Collections
+	case associationTag_:
+		values, err = c.decodeValues(node)
+		if err == nil && len(values) != 2 {
+			err = fmt.Errorf("An association must have a key and a value.")
+		}
+		if err == nil {
+			value = AssociationClass[any, any]().Association(values[0], values[1])
+		}
+	case catalogTag_:
+		values, err = c.decodeValues(node)
+		if err == nil && len(values)%2 != 0 {
+			err = fmt.Errorf("Each key in a catalog must have a value.")
+		}
+		var catalog = CatalogClass[any, any]().Catalog()
+		for index := 0; err == nil && index < len(values); index += 2 {
+			catalog.SetValue(values[index], values[index+1])
+			if int(catalog.GetSize()) != index/2+1 {
+				// Otherwise different bytes would decode to the same catalog.
+				err = fmt.Errorf("A duplicate catalog key was encoded: %v", values[index])
+			}
+		}
+		value = catalog
+	case listTag_:
+		values, err = c.decodeValues(node)
+		value = ListClass[any]().ListFromArray(values)
+	case queueTag_:
+		values, err = c.decodeValues(node)
+		var queue = QueueClass[any]().QueueWithCapacity(uint(len(values)))
+		for _, item := range values {
+			queue.AddValue(item)
+		}
+		value = queue
+	case setTag_:
+		values, err = c.decodeValues(node)
+		value = SetClass[any]().SetFromArray(values)
+	case stackTag_:
+		values, err = c.decodeValues(node)
+		value = StackClass[any]().StackFromArray(values)
+	case bagTag_:
+		values, err = c.decodeValues(node)
+		value = BagClass[any]().BagFromArray(values)
+	default:
+		err = fmt.Errorf("An unknown type tag (%d) was encoded.", node.Tag)
+	}
+	if err != nil {
+		value = nil
+	}
+	return
+}
+
+// Instance Structure
+
+type codec_ struct {
+	// Declare the instance attributes.
+	encoder_ age.EncoderLike
+}
+
+// Class Structure
+
+type codecClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func codecClass() *codecClass_ {
+	return codecClassReference_
+}
+
+var codecClassReference_ = &codecClass_{
+	// Initialize the class constants.
+}
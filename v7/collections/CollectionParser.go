@@ -0,0 +1,465 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	ele "github.com/craterdog/go-component-framework/v7/elements"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	ref "reflect"
+	sts "strings"
+	uni "unicode"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func CollectionParserClass() CollectionParserClassLike {
+	return collectionParserClass()
+}
+
+// Constructor Methods
+
+func (c *collectionParserClass_) CollectionParser() CollectionParserLike {
+	var instance = &collectionParser_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *collectionParser_) GetClass() CollectionParserClassLike {
+	return collectionParserClass()
+}
+
+func (v *collectionParser_) ParseCollection(
+	source string,
+) (
+	collection any,
+	length uint,
+) {
+	var class = collectionParserClass()
+	var value, next, ok = class.parseCollection(source, 0)
+	if ok {
+		collection = value
+		length = uint(next)
+	}
+	return
+}
+
+func (v *collectionParser_) ParseValue(
+	source string,
+) (
+	value any,
+	length uint,
+) {
+	var class = collectionParserClass()
+	var literal, next, ok = class.parseValue(source, 0)
+	if ok {
+		value = literal
+		length = uint(next)
+	}
+	return
+}
+
+// Attribute Methods
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// This private class method assigns the specified value to the variable that
+// the specified target points to if the type of the value allows it.
+func (c *collectionParserClass_) assignValue(
+	value any,
+	target any,
+) bool {
+	var valueRef = ref.ValueOf(value)
+	var targetRef = ref.ValueOf(target).Elem()
+	if !valueRef.IsValid() || !valueRef.Type().AssignableTo(targetRef.Type()) {
+		return false
+	}
+	targetRef.Set(valueRef)
+	return true
+}
+
+// This private class method decodes the specified JSON object key into the
+// variable that the specified target points to.  A key containing a literal is
+// decoded into its element, string or collection if the type of the target
+// allows it, otherwise the key is decoded as a JSON string, or failing that, as
+// a JSON value (e.g. a number).
+func (c *collectionParserClass_) decodeKey(
+	key string,
+	target any,
+) error {
+	var value, ok = c.parseLiteral(key)
+	if ok && c.assignValue(value, target) {
+		return nil
+	}
+	var bytes, _ = jsn.Marshal(key)
+	var err = jsn.Unmarshal(bytes, target)
+	if err != nil {
+		err = jsn.Unmarshal([]byte(key), target)
+	}
+	return err
+}
+
+// This private class method decodes the members of the specified JSON object
+// in the order in which they appear, which a Go map cannot preserve.
+func (c *collectionParserClass_) decodeMembers(
+	bytes []byte,
+) (
+	keys []string,
+	values []jsn.RawMessage,
+	err error,
+) {
+	var decoder = jsn.NewDecoder(sts.NewReader(string(bytes)))
+	var token jsn.Token
+	token, err = decoder.Token()
+	if err != nil {
+		return
+	}
+	if token != jsn.Delim('{') {
+		err = fmt.Errorf("A JSON object was expected: %s", bytes)
+		return
+	}
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return
+		}
+		var value jsn.RawMessage
+		err = decoder.Decode(&value)
+		if err != nil {
+			return
+		}
+		keys = append(keys, token.(string))
+		values = append(values, value)
+	}
+	_, err = decoder.Token() // Consume the closing brace.
+	return
+}
+
+// This private class method decodes the specified JSON value into the variable
+// that the specified target points to.  A JSON string containing a literal, a
+// JSON array and a JSON object are decoded into an element or string, a
+// ListLike[any] and a CatalogLike[any, any] respectively if the type of the
+// target allows it, otherwise the standard JSON decoding is used.
+func (c *collectionParserClass_) decodeValue(
+	bytes []byte,
+	target any,
+) error {
+	var value, ok = c.decodeLiteral(bytes)
+	if ok && c.assignValue(value, target) {
+		return nil
+	}
+	return jsn.Unmarshal(bytes, target)
+}
+
+// This private class method decodes the specified JSON value into an element,
+// string or collection if possible.
+func (c *collectionParserClass_) decodeLiteral(
+	bytes []byte,
+) (
+	value any,
+	ok bool,
+) {
+	var decoder = jsn.NewDecoder(sts.NewReader(string(bytes)))
+	var token, err = decoder.Token()
+	if err != nil {
+		return
+	}
+	switch token {
+	case jsn.Delim('['):
+		var list = ListClass[any]().List()
+		if jsn.Unmarshal(bytes, list) == nil {
+			value = list
+			ok = true
+		}
+	case jsn.Delim('{'):
+		var catalog = CatalogClass[any, any]().Catalog()
+		if jsn.Unmarshal(bytes, catalog) == nil {
+			value = catalog
+			ok = true
+		}
+	default:
+		var source, isString = token.(string)
+		if isString {
+			value, ok = c.parseLiteral(source)
+		}
+	}
+	return
+}
+
+// This private class method returns the JSON object member for the specified
+// key and value.
+func (c *collectionParserClass_) encodeMember(
+	key any,
+	value any,
+) ([]byte, error) {
+	var name = c.formatValue(key)
+	var string_, isString = key.(string)
+	if isString {
+		// Go strings are used as is rather than as quoted literals.
+		name = string_
+	}
+	var member, err = jsn.Marshal(name)
+	if err != nil {
+		return nil, err
+	}
+	var bytes []byte
+	bytes, err = jsn.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	member = append(member, ':')
+	member = append(member, bytes...)
+	return member, nil
+}
+
+// This private class method returns the collection literal notation for the
+// specified value.  Elements and strings use their canonical literal form and
+// nested collections use their own notation.
+func (c *collectionParserClass_) formatValue(
+	value any,
+) string {
+	switch actual := value.(type) {
+	case interface{ AsString() string }:
+		return actual.AsString()
+	case fmt.Stringer:
+		return actual.String()
+	default:
+		return uti.Format(actual)
+	}
+}
+
+// This private class method parses the collection literal that begins at the
+// specified position in the source string.  It returns the collection and the
+// position following its closing bracket.
+func (c *collectionParserClass_) parseCollection(
+	source string,
+	position int,
+) (
+	collection any,
+	next int,
+	ok bool,
+) {
+	if !sts.HasPrefix(source[position:], "[") {
+		return
+	}
+	position = c.skipSpace(source, position+1)
+
+	// Check for an empty catalog.
+	if sts.HasPrefix(source[position:], ":") {
+		position = c.skipSpace(source, position+1)
+		if !sts.HasPrefix(source[position:], "]") {
+			return
+		}
+		collection = CatalogClass[any, any]().Catalog()
+		next = position + 1
+		ok = true
+		return
+	}
+
+	// Check for an empty list.
+	if sts.HasPrefix(source[position:], "]") {
+		collection = ListClass[any]().List()
+		next = position + 1
+		ok = true
+		return
+	}
+
+	// Parse the values, or the key-value associations, in the collection.  The
+	// first item determines which kind of collection it is.
+	var list = ListClass[any]().List()
+	var catalog CatalogLike[any, any]
+	for {
+		var value any
+		value, position, ok = c.parseValue(source, position)
+		if !ok {
+			return
+		}
+		position = c.skipSpace(source, position)
+		if list.IsEmpty() && catalog == nil && sts.HasPrefix(source[position:], ":") {
+			catalog = CatalogClass[any, any]().Catalog()
+		}
+		if catalog != nil {
+			// The value is the key for an association.
+			if !sts.HasPrefix(source[position:], ":") {
+				ok = false
+				return
+			}
+			position = c.skipSpace(source, position+1)
+			var association any
+			association, position, ok = c.parseValue(source, position)
+			if !ok {
+				return
+			}
+			catalog.SetValue(value, association)
+			position = c.skipSpace(source, position)
+		} else {
+			list.AppendValue(value)
+		}
+		switch {
+		case sts.HasPrefix(source[position:], ","):
+			position = c.skipSpace(source, position+1)
+		case sts.HasPrefix(source[position:], "]"):
+			collection = list
+			if catalog != nil {
+				collection = catalog
+			}
+			next = position + 1
+			return
+		default:
+			ok = false
+			return
+		}
+	}
+}
+
+// This private class method parses the specified source string as a single
+// collection, element or string literal.
+func (c *collectionParserClass_) parseLiteral(
+	source string,
+) (
+	value any,
+	ok bool,
+) {
+	var next int
+	value, next, ok = c.parseValue(source, 0)
+	if !ok || next != len(source) {
+		value = nil
+		ok = false
+	}
+	return
+}
+
+// This private class method parses the collection, element or string literal
+// that begins at the specified position in the source string.  It returns the
+// value and the position following its literal.
+func (c *collectionParserClass_) parseValue(
+	source string,
+	position int,
+) (
+	value any,
+	next int,
+	ok bool,
+) {
+	if sts.HasPrefix(source[position:], "[") {
+		return c.parseCollection(source, position)
+	}
+
+	// The longest literal wins and elements take precedence over strings.
+	var element, elementLength = c.elementParser_.ParseElement(source[position:])
+	var string_, stringLength = c.stringParser_.ParseString(source[position:])
+	switch {
+	case elementLength > 0 && elementLength >= stringLength:
+		value = element
+		next = position + int(elementLength)
+		ok = true
+	case stringLength > 0:
+		value = string_
+		next = position + int(stringLength)
+		ok = true
+	}
+	return
+}
+
+// This private class method parses the specified source string as a catalog
+// literal, panicking if the source string is not one.
+func (c *collectionParserClass_) parseCatalog(
+	source string,
+) CatalogLike[any, any] {
+	var collection, next, ok = c.parseCollection(source, 0)
+	var catalog CatalogLike[any, any]
+	if ok && next == len(source) {
+		catalog, ok = collection.(CatalogLike[any, any])
+	}
+	if !ok || next != len(source) {
+		var message = fmt.Sprintf(
+			"An illegal string was passed to the catalog constructor method: %s",
+			source,
+		)
+		panic(message)
+	}
+	return catalog
+}
+
+// This private class method parses the specified source string as a sequence
+// literal, panicking if the source string is not one.  The name of the class
+// being constructed is used in the panic message.
+func (c *collectionParserClass_) parseSequence(
+	source string,
+	class string,
+) ListLike[any] {
+	var collection, next, ok = c.parseCollection(source, 0)
+	var list ListLike[any]
+	if ok && next == len(source) {
+		list, ok = collection.(ListLike[any])
+	}
+	if !ok || next != len(source) {
+		var message = fmt.Sprintf(
+			"An illegal string was passed to the %s constructor method: %s",
+			class,
+			source,
+		)
+		panic(message)
+	}
+	return list
+}
+
+// This private class method returns the position of the first non-whitespace
+// character at or after the specified position in the source string.
+func (c *collectionParserClass_) skipSpace(
+	source string,
+	position int,
+) int {
+	var trimmed = sts.TrimLeftFunc(source[position:], uni.IsSpace)
+	return len(source) - len(trimmed)
+}
+
+// Instance Structure
+
+type collectionParser_ struct {
+	// Declare the instance attributes.
+}
+
+// Class Structure
+
+type collectionParserClass_ struct {
+	// Declare the class constants.
+	elementParser_ ele.ElementParserLike
+	stringParser_  str.StringParserLike
+}
+
+// Class Reference
+
+func collectionParserClass() *collectionParserClass_ {
+	return collectionParserClassReference_
+}
+
+var collectionParserClassReference_ = &collectionParserClass_{
+	// Initialize the class constants.
+	elementParser_: ele.ElementParserClass().ElementParser(),
+	stringParser_:  str.StringParserClass().StringParser(),
+}
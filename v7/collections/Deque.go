@@ -0,0 +1,435 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DequeClass[V any]() DequeClassLike[V] {
+	return dequeClass[V]()
+}
+
+// Constructor Methods
+
+func (c *dequeClass_[V]) Deque() DequeLike[V] {
+	var instance = &deque_[V]{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+func (c *dequeClass_[V]) BlockingDeque(
+	capacity uint,
+) DequeLike[V] {
+	if capacity < 1 {
+		capacity = 16 // This is the default capacity.
+	}
+	var instance = &deque_[V]{
+		// Initialize the instance attributes.
+		capacity_: capacity,
+	}
+	return instance
+}
+
+func (c *dequeClass_[V]) DequeFromArray(
+	values []V,
+) DequeLike[V] {
+	var deque = c.Deque()
+	for _, value := range values {
+		deque.AddLast(value)
+	}
+	return deque
+}
+
+func (c *dequeClass_[V]) DequeFromSequence(
+	values str.Sequential[V],
+) DequeLike[V] {
+	var deque = c.Deque()
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		deque.AddLast(value)
+	}
+	return deque
+}
+
+func (c *dequeClass_[V]) DequeFromString(
+	source string,
+) DequeLike[V] {
+	var values = collectionParserClass().parseSequence(source, "deque")
+	var deque = c.Deque()
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value, ok = iterator.GetNext().(V)
+		if !ok {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the deque constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		deque.AddLast(value)
+	}
+	return deque
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *deque_[V]) GetClass() DequeClassLike[V] {
+	return dequeClass[V]()
+}
+
+func (v *deque_[V]) AddFirst(
+	value V,
+) {
+	v.mutex_.Lock()
+	v.awaitRoom() // Will block when full.
+	v.grow()
+	v.head_ = (v.head_ + len(v.values_) - 1) % len(v.values_)
+	v.values_[v.head_] = value
+	v.size_++
+	v.wake(&v.notEmpty_)
+	v.mutex_.Unlock()
+}
+
+func (v *deque_[V]) AddLast(
+	value V,
+) {
+	v.mutex_.Lock()
+	v.awaitRoom() // Will block when full.
+	v.grow()
+	v.values_[(v.head_+v.size_)%len(v.values_)] = value
+	v.size_++
+	v.wake(&v.notEmpty_)
+	v.mutex_.Unlock()
+}
+
+func (v *deque_[V]) GetFirst() (
+	first V,
+	ok bool,
+) {
+	v.mutex_.Lock()
+	ok = v.size_ > 0
+	if ok {
+		first = v.values_[v.head_]
+	}
+	v.mutex_.Unlock()
+	return
+}
+
+func (v *deque_[V]) GetLast() (
+	last V,
+	ok bool,
+) {
+	v.mutex_.Lock()
+	ok = v.size_ > 0
+	if ok {
+		last = v.values_[(v.head_+v.size_-1)%len(v.values_)]
+	}
+	v.mutex_.Unlock()
+	return
+}
+
+func (v *deque_[V]) RemoveLast() (
+	last V,
+	ok bool,
+) {
+	v.mutex_.Lock()
+	ok = v.awaitValue() // Will block when empty.
+	if ok {
+		var slot = (v.head_ + v.size_ - 1) % len(v.values_)
+		last = v.values_[slot]
+		var zero V
+		v.values_[slot] = zero // Allow the value to be garbage collected.
+		v.size_--
+		v.wake(&v.notFull_)
+	}
+	v.mutex_.Unlock()
+	return
+}
+
+// Attribute Methods
+
+func (v *deque_[V]) GetCapacity() uint {
+	v.mutex_.Lock()
+	var capacity = v.capacity_
+	v.mutex_.Unlock()
+	return capacity
+}
+
+// Fifo[V] Methods
+
+func (v *deque_[V]) AddValue(
+	value V,
+) {
+	v.AddLast(value)
+}
+
+func (v *deque_[V]) RemoveFirst() (
+	first V,
+	ok bool,
+) {
+	v.mutex_.Lock()
+	ok = v.awaitValue() // Will block when empty.
+	if ok {
+		first = v.values_[v.head_]
+		var zero V
+		v.values_[v.head_] = zero // Allow the value to be garbage collected.
+		v.head_ = (v.head_ + 1) % len(v.values_)
+		v.size_--
+		v.wake(&v.notFull_)
+	}
+	v.mutex_.Unlock()
+	return
+}
+
+func (v *deque_[V]) RemoveAll() {
+	v.mutex_.Lock()
+	clear(v.values_) // Allow the values to be garbage collected.
+	v.head_ = 0
+	v.size_ = 0
+	v.wake(&v.notFull_)
+	v.mutex_.Unlock()
+}
+
+func (v *deque_[V]) CloseChannel() {
+	v.mutex_.Lock()
+	if v.capacity_ > 0 {
+		v.closed_ = true
+		// No more values can be placed on the deque.
+		v.wake(&v.notEmpty_)
+		v.wake(&v.notFull_)
+	}
+	v.mutex_.Unlock()
+}
+
+// str.Sequential[V] Methods
+
+func (v *deque_[V]) IsEmpty() bool {
+	v.mutex_.Lock()
+	var result = v.size_ == 0
+	v.mutex_.Unlock()
+	return result
+}
+
+func (v *deque_[V]) GetSize() uint {
+	v.mutex_.Lock()
+	var size = uint(v.size_)
+	v.mutex_.Unlock()
+	return size
+}
+
+func (v *deque_[V]) AsArray() []V {
+	v.mutex_.Lock()
+	var array = make([]V, v.size_)
+	for index := range array {
+		array[index] = v.values_[(v.head_+index)%len(v.values_)]
+	}
+	v.mutex_.Unlock()
+	return array
+}
+
+func (v *deque_[V]) GetIterator() age.IteratorLike[V] {
+	var iteratorClass = age.IteratorClass[V]()
+	var iterator = iteratorClass.Iterator(v.AsArray())
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *deque_[V]) String() string {
+	return fmt.Sprintf("%v", ListClass[V]().ListFromArray(v.AsArray()))
+}
+
+func (v *deque_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsArray())
+}
+
+func (v *deque_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var list = ListClass[V]().List()
+	var err = jsn.Unmarshal(bytes, list)
+	if err != nil {
+		return err
+	}
+	var values = list.AsArray()
+	v.mutex_.Lock()
+	var size = uint(len(values))
+	if v.capacity_ > 0 && size > v.capacity_ {
+		v.capacity_ = size
+	}
+	v.values_ = values
+	v.head_ = 0
+	v.size_ = len(values)
+	v.wake(&v.notEmpty_)
+	v.mutex_.Unlock()
+	return nil
+}
+
+// Private Methods
+
+// This private instance method waits until there is room for another value in
+// a blocking deque.  It panics if the deque has been closed.  A non-blocking
+// deque never waits.  The mutex must be locked when it is called and is locked
+// again when it returns.
+func (v *deque_[V]) awaitRoom() {
+	for v.capacity_ > 0 {
+		switch {
+		case v.closed_:
+			v.mutex_.Unlock()
+			panic("A value cannot be added to a closed deque.")
+		case uint(v.size_) < v.capacity_:
+			return
+		}
+
+		// Wait for a value to be removed from the deque.
+		var signal = v.signal(&v.notFull_)
+		v.mutex_.Unlock()
+		<-signal
+		v.mutex_.Lock()
+	}
+}
+
+// This private instance method waits until a value is available in a blocking
+// deque.  It returns false if the deque is empty and either does not block or
+// has been closed.  The mutex must be locked when it is called and is locked
+// again when it returns.
+func (v *deque_[V]) awaitValue() bool {
+	for v.size_ == 0 {
+		if v.capacity_ == 0 || v.closed_ {
+			return false
+		}
+
+		// Wait for a value to be added to the deque.
+		var signal = v.signal(&v.notEmpty_)
+		v.mutex_.Unlock()
+		<-signal
+		v.mutex_.Lock()
+	}
+	return true
+}
+
+// This private instance method doubles the size of the ring buffer holding the
+// values when it is full, up to the capacity of a blocking deque.  The values
+// are copied so that the first value is at the start of the new ring buffer.
+func (v *deque_[V]) grow() {
+	if v.size_ < len(v.values_) {
+		return
+	}
+	var size = max(2*len(v.values_), 8)
+	if v.capacity_ > 0 {
+		size = min(size, int(v.capacity_))
+	}
+	var values = make([]V, size)
+	for index := range v.size_ {
+		values[index] = v.values_[(v.head_+index)%len(v.values_)]
+	}
+	v.values_ = values
+	v.head_ = 0
+}
+
+// This private instance method returns the specified signal channel, creating
+// it if no other go-routine is already waiting on it.  The mutex must be locked
+// when it is called.
+func (v *deque_[V]) signal(
+	signal *chan struct{},
+) <-chan struct{} {
+	if *signal == nil {
+		*signal = make(chan struct{})
+	}
+	return *signal
+}
+
+// This private instance method wakes up all go-routines waiting on the
+// specified signal channel, if any, by closing it.  The mutex must be locked
+// when it is called.
+func (v *deque_[V]) wake(
+	signal *chan struct{},
+) {
+	if *signal != nil {
+		close(*signal)
+		*signal = nil
+	}
+}
+
+// Instance Structure
+
+// NOTE:
+// The values are maintained in a ring buffer so that values can be added to or
+// removed from either end in O[1] time.  As with a queue, the ring buffer of a
+// blocking deque grows as needed up to its capacity, and its signal channels
+// are only created while go-routines are waiting for a value to be added
+// (notEmpty) or removed (notFull).  They are closed to wake all of them up.
+type deque_[V any] struct {
+	// Declare the instance attributes.
+	capacity_ uint
+	closed_   bool
+	head_     int
+	mutex_    syn.Mutex
+	notEmpty_ chan struct{}
+	notFull_  chan struct{}
+	size_     int
+	values_   []V
+}
+
+// Class Structure
+
+type dequeClass_[V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var dequeMap_ = map[string]any{}
+var dequeMutex_ syn.Mutex
+
+func dequeClass[V any]() *dequeClass_[V] {
+	// Generate the name of the bound class type.
+	var class *dequeClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	dequeMutex_.Lock()
+	var value = dequeMap_[name]
+	switch actual := value.(type) {
+	case *dequeClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &dequeClass_[V]{
+			// Initialize the class constants.
+		}
+		dequeMap_[name] = class
+	}
+	dequeMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
@@ -0,0 +1,419 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DictionaryClass[K any, V any]() DictionaryClassLike[K, V] {
+	return dictionaryClass[K, V]()
+}
+
+// Constructor Methods
+
+func (c *dictionaryClass_[K, V]) Dictionary() DictionaryLike[K, V] {
+	var collatorClass = age.CollatorClass[K]()
+	var collator = collatorClass.Collator()
+	var instance = c.DictionaryWithCollator(collator)
+	return instance
+}
+
+func (c *dictionaryClass_[K, V]) DictionaryWithCollator(
+	collator age.CollatorLike[K],
+) DictionaryLike[K, V] {
+	if uti.IsUndefined(collator) {
+		panic("The \"collator\" attribute is required by this class.")
+	}
+	var instance = &dictionary_[K, V]{
+		// Initialize the instance attributes.
+		collator_: collator,
+	}
+	return instance
+}
+
+func (c *dictionaryClass_[K, V]) DictionaryFromArray(
+	associations []AssociationLike[K, V],
+) DictionaryLike[K, V] {
+	var dictionary = c.Dictionary()
+	for _, association := range associations {
+		var key = association.GetKey()
+		var value = association.GetValue()
+		dictionary.SetValue(key, value)
+	}
+	return dictionary
+}
+
+func (c *dictionaryClass_[K, V]) DictionaryFromSequence(
+	associations str.Sequential[AssociationLike[K, V]],
+) DictionaryLike[K, V] {
+	var dictionary = c.Dictionary()
+	var iterator = associations.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var key = association.GetKey()
+		var value = association.GetValue()
+		dictionary.SetValue(key, value)
+	}
+	return dictionary
+}
+
+func (c *dictionaryClass_[K, V]) DictionaryFromString(
+	source string,
+) DictionaryLike[K, V] {
+	var associations = collectionParserClass().parseCatalog(source)
+	var dictionary = c.Dictionary()
+	var iterator = associations.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var key, keyOk = association.GetKey().(K)
+		var value, valueOk = association.GetValue().(V)
+		if !keyOk || !valueOk {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the dictionary constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		dictionary.SetValue(key, value)
+	}
+	return dictionary
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *dictionaryClass_[K, V]) Merge(
+	first DictionaryLike[K, V],
+	second DictionaryLike[K, V],
+) DictionaryLike[K, V] {
+	var collator = first.GetCollator()
+	var dictionary = c.DictionaryWithCollator(collator)
+	var iterator = first.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		dictionary.SetValue(association.GetKey(), association.GetValue())
+	}
+	iterator = second.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		dictionary.SetValue(association.GetKey(), association.GetValue())
+	}
+	return dictionary
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *dictionary_[K, V]) GetClass() DictionaryClassLike[K, V] {
+	return dictionaryClass[K, V]()
+}
+
+func (v *dictionary_[K, V]) GetFirst() AssociationLike[K, V] {
+	if v.root_ == nil {
+		return nil
+	}
+	return v.root_.getValue(0)
+}
+
+func (v *dictionary_[K, V]) GetLast() AssociationLike[K, V] {
+	if v.root_ == nil {
+		return nil
+	}
+	return v.root_.getValue(v.root_.getSize() - 1)
+}
+
+func (v *dictionary_[K, V]) GetFloor(
+	key K,
+) AssociationLike[K, V] {
+	var slot, found = v.root_.findSlot(v.ranker(key))
+	switch {
+	case found:
+		return v.root_.getValue(slot)
+	case slot > 0:
+		// The previous association has the greatest lesser key.
+		return v.root_.getValue(slot - 1)
+	default:
+		// All keys are greater than the specified key.
+		return nil
+	}
+}
+
+func (v *dictionary_[K, V]) GetCeiling(
+	key K,
+) AssociationLike[K, V] {
+	var slot, _ = v.root_.findSlot(v.ranker(key))
+	if slot == v.root_.getSize() {
+		// All keys are less than the specified key.
+		return nil
+	}
+	return v.root_.getValue(slot)
+}
+
+func (v *dictionary_[K, V]) GetRange(
+	first K,
+	last K,
+) DictionaryLike[K, V] {
+	var start, _ = v.root_.findSlot(v.ranker(first))
+	var end, found = v.root_.findSlot(v.ranker(last))
+	if found {
+		// The last key is included in the range.
+		end++
+	}
+	var array []AssociationLike[K, V]
+	var associationClass = AssociationClass[K, V]()
+	for slot := start; slot < end; slot++ {
+		var association = v.root_.getValue(slot)
+		var key = association.GetKey()
+		var value = association.GetValue()
+		array = append(array, associationClass.Association(key, value))
+	}
+	var instance = &dictionary_[K, V]{
+		collator_: v.collator_,
+		root_:     nodeFromArray(array),
+	}
+	return instance
+}
+
+// Attribute Methods
+
+func (v *dictionary_[K, V]) GetCollator() age.CollatorLike[K] {
+	return v.collator_
+}
+
+func (v *dictionary_[K, V]) GetValue(
+	key K,
+) V {
+	var value V // Set the return value to its zero value.
+	var slot, found = v.root_.findSlot(v.ranker(key))
+	if found {
+		// Extract the value.
+		value = v.root_.getValue(slot).GetValue()
+	}
+	return value
+}
+
+func (v *dictionary_[K, V]) SetValue(
+	key K,
+	value V,
+) {
+	var slot, found = v.root_.findSlot(v.ranker(key))
+	if found {
+		// Set the value of an existing association.
+		v.root_.getValue(slot).SetValue(value)
+	} else {
+		// Add a new association.
+		var associationClass = AssociationClass[K, V]()
+		var association = associationClass.Association(key, value)
+		v.root_ = v.root_.insertValue(slot, association)
+	}
+}
+
+func (v *dictionary_[K, V]) GetKeys() str.Sequential[K] {
+	var listClass = ListClass[K]()
+	var keys = listClass.List()
+	for _, association := range v.AsArray() {
+		keys.AppendValue(association.GetKey())
+	}
+	return keys
+}
+
+func (v *dictionary_[K, V]) GetValues(
+	keys str.Sequential[K],
+) str.Sequential[V] {
+	var listClass = ListClass[V]()
+	var values = listClass.List()
+	var iterator = keys.GetIterator()
+	for iterator.HasNext() {
+		var key = iterator.GetNext()
+		values.AppendValue(v.GetValue(key))
+	}
+	return values
+}
+
+func (v *dictionary_[K, V]) RemoveValue(
+	key K,
+) V {
+	var old V // Set the return value to its zero value.
+	var slot, found = v.root_.findSlot(v.ranker(key))
+	if found {
+		old = v.root_.getValue(slot).GetValue()
+		v.root_ = v.root_.removeValue(slot)
+	}
+	return old
+}
+
+func (v *dictionary_[K, V]) RemoveValues(
+	keys str.Sequential[K],
+) str.Sequential[V] {
+	var listClass = ListClass[V]()
+	var values = listClass.List()
+	var iterator = keys.GetIterator()
+	for iterator.HasNext() {
+		var key = iterator.GetNext()
+		values.AppendValue(v.RemoveValue(key))
+	}
+	return values
+}
+
+func (v *dictionary_[K, V]) RemoveAll() {
+	v.root_ = nil
+}
+
+// str.Sequential[AssociationLike[K, V]] Methods
+
+func (v *dictionary_[K, V]) IsEmpty() bool {
+	return v.root_ == nil
+}
+
+func (v *dictionary_[K, V]) GetSize() uint {
+	return v.root_.getSize()
+}
+
+func (v *dictionary_[K, V]) AsArray() []AssociationLike[K, V] {
+	var array = make([]AssociationLike[K, V], 0, v.GetSize())
+	return v.root_.appendValues(array)
+}
+
+func (v *dictionary_[K, V]) GetIterator() age.IteratorLike[AssociationLike[K, V]] {
+	var iteratorClass = age.IteratorClass[AssociationLike[K, V]]()
+	var iterator = iteratorClass.Iterator(v.AsArray())
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *dictionary_[K, V]) String() string {
+	if v.root_ == nil {
+		return "[:]"
+	}
+	var listClass = ListClass[AssociationLike[K, V]]()
+	return fmt.Sprintf("%v", listClass.ListFromArray(v.AsArray()))
+}
+
+func (v *dictionary_[K, V]) MarshalJSON() ([]byte, error) {
+	// The associations are written out one at a time to preserve their order.
+	var class = collectionParserClass()
+	var bytes = []byte{'{'}
+	for index, association := range v.AsArray() {
+		var member, err = class.encodeMember(
+			association.GetKey(),
+			association.GetValue(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if index > 0 {
+			bytes = append(bytes, ',')
+		}
+		bytes = append(bytes, member...)
+	}
+	bytes = append(bytes, '}')
+	return bytes, nil
+}
+
+func (v *dictionary_[K, V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var class = collectionParserClass()
+	var names, members, err = class.decodeMembers(bytes)
+	if err != nil {
+		return err
+	}
+	var keys = make([]K, len(names))
+	var values = make([]V, len(members))
+	for index, name := range names {
+		err = class.decodeKey(name, &keys[index])
+		if err != nil {
+			return err
+		}
+		err = class.decodeValue(members[index], &values[index])
+		if err != nil {
+			return err
+		}
+	}
+	if uti.IsUndefined(v.collator_) {
+		v.collator_ = age.CollatorClass[K]().Collator()
+	}
+	v.RemoveAll()
+	for index, key := range keys {
+		v.SetValue(key, values[index])
+	}
+	return nil
+}
+
+// Private Methods
+
+// This private instance method returns a function that ranks the specified
+// key against the key of a candidate association in the dictionary.
+func (v *dictionary_[K, V]) ranker(
+	key K,
+) func(candidate AssociationLike[K, V]) age.Rank {
+	return func(candidate AssociationLike[K, V]) age.Rank {
+		return v.collator_.RankValues(key, candidate.GetKey())
+	}
+}
+
+// Instance Structure
+
+type dictionary_[K any, V any] struct {
+	// Declare the instance attributes.
+	collator_ age.CollatorLike[K]
+	root_     *node_[AssociationLike[K, V]]
+}
+
+// Class Structure
+
+type dictionaryClass_[K any, V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var dictionaryMap_ = map[string]any{}
+var dictionaryMutex_ syn.Mutex
+
+func dictionaryClass[K any, V any]() *dictionaryClass_[K, V] {
+	// Generate the name of the bound class type.
+	var class *dictionaryClass_[K, V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	dictionaryMutex_.Lock()
+	var value = dictionaryMap_[name]
+	switch actual := value.(type) {
+	case *dictionaryClass_[K, V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &dictionaryClass_[K, V]{
+			// Initialize the class constants.
+		}
+		dictionaryMap_[name] = class
+	}
+	dictionaryMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
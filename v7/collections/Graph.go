@@ -0,0 +1,476 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	slc "slices"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func GraphClass[V comparable]() GraphClassLike[V] {
+	return graphClass[V]()
+}
+
+// Constructor Methods
+
+func (c *graphClass_[V]) Graph() GraphLike[V] {
+	var catalogClass = CatalogClass[V, SetLike[V]]()
+	var instance = &graph_[V]{
+		// Initialize the instance attributes.
+		edges_: catalogClass.Catalog(),
+	}
+	return instance
+}
+
+func (c *graphClass_[V]) GraphFromCatalog(
+	adjacencies CatalogLike[V, ListLike[V]],
+) GraphLike[V] {
+	if uti.IsUndefined(adjacencies) {
+		panic("The \"adjacencies\" attribute is required by this class.")
+	}
+	// Add the nodes first so that they retain the order of the catalog.
+	var graph = c.Graph()
+	var keys = adjacencies.GetKeys().GetIterator()
+	for keys.HasNext() {
+		graph.AddNode(keys.GetNext())
+	}
+	var iterator = adjacencies.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var node = association.GetKey()
+		var successors = association.GetValue()
+		if uti.IsUndefined(successors) {
+			continue
+		}
+		var targets = successors.GetIterator()
+		for targets.HasNext() {
+			graph.AddEdge(node, targets.GetNext())
+		}
+	}
+	return graph
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *graph_[V]) GetClass() GraphClassLike[V] {
+	return graphClass[V]()
+}
+
+func (v *graph_[V]) AsCatalog() CatalogLike[V, ListLike[V]] {
+	var catalog = CatalogClass[V, ListLike[V]]().Catalog()
+	var iterator = v.edges_.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var successors = ListClass[V]().ListFromSequence(association.GetValue())
+		catalog.SetValue(association.GetKey(), successors)
+	}
+	return catalog
+}
+
+func (v *graph_[V]) AddNode(
+	node V,
+) {
+	if v.edges_.GetValue(node) == nil {
+		v.edges_.SetValue(node, SetClass[V]().Set())
+	}
+}
+
+func (v *graph_[V]) RemoveNode(
+	node V,
+) {
+	if v.edges_.RemoveValue(node) == nil {
+		return
+	}
+	// Remove the edges that lead to the node.
+	var iterator = v.edges_.GetIterator()
+	for iterator.HasNext() {
+		var successors = iterator.GetNext().GetValue()
+		successors.RemoveValue(node)
+	}
+}
+
+func (v *graph_[V]) ContainsNode(
+	node V,
+) bool {
+	return v.edges_.GetValue(node) != nil
+}
+
+func (v *graph_[V]) AddEdge(
+	source V,
+	target V,
+) {
+	v.AddNode(source)
+	v.AddNode(target)
+	v.edges_.GetValue(source).AddValue(target)
+}
+
+func (v *graph_[V]) RemoveEdge(
+	source V,
+	target V,
+) {
+	var successors = v.edges_.GetValue(source)
+	if successors != nil {
+		successors.RemoveValue(target)
+	}
+}
+
+func (v *graph_[V]) ContainsEdge(
+	source V,
+	target V,
+) bool {
+	var successors = v.edges_.GetValue(source)
+	return successors != nil && successors.ContainsValue(target)
+}
+
+func (v *graph_[V]) GetEdgeCount() uint {
+	var count uint
+	var iterator = v.edges_.GetIterator()
+	for iterator.HasNext() {
+		count += iterator.GetNext().GetValue().GetSize()
+	}
+	return count
+}
+
+func (v *graph_[V]) GetSuccessors(
+	node V,
+) age.IteratorLike[V] {
+	var iteratorClass = age.IteratorClass[V]()
+	return iteratorClass.Iterator(v.successorsOf(node))
+}
+
+func (v *graph_[V]) GetPredecessors(
+	node V,
+) age.IteratorLike[V] {
+	var predecessors = []V{}
+	var iterator = v.edges_.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		if association.GetValue().ContainsValue(node) {
+			predecessors = append(predecessors, association.GetKey())
+		}
+	}
+	var iteratorClass = age.IteratorClass[V]()
+	return iteratorClass.Iterator(predecessors)
+}
+
+func (v *graph_[V]) BreadthFirst(
+	start V,
+) age.IteratorLike[V] {
+	var nodes = []V{}
+	if v.ContainsNode(start) {
+		var visited = map[V]bool{start: true}
+		nodes = append(nodes, start)
+		for next := 0; next < len(nodes); next++ {
+			for _, successor := range v.successorsOf(nodes[next]) {
+				if !visited[successor] {
+					visited[successor] = true
+					nodes = append(nodes, successor)
+				}
+			}
+		}
+	}
+	var iteratorClass = age.IteratorClass[V]()
+	return iteratorClass.Iterator(nodes)
+}
+
+func (v *graph_[V]) DepthFirst(
+	start V,
+) age.IteratorLike[V] {
+	var nodes = []V{}
+	if v.ContainsNode(start) {
+		var visited = map[V]bool{}
+		var visit func(node V)
+		visit = func(node V) {
+			visited[node] = true
+			nodes = append(nodes, node)
+			for _, successor := range v.successorsOf(node) {
+				if !visited[successor] {
+					visit(successor)
+				}
+			}
+		}
+		visit(start)
+	}
+	var iteratorClass = age.IteratorClass[V]()
+	return iteratorClass.Iterator(nodes)
+}
+
+func (v *graph_[V]) FindCycle() str.Sequential[V] {
+	var listClass = ListClass[V]()
+	return listClass.ListFromArray(v.findCycle())
+}
+
+func (v *graph_[V]) SortTopologically() (
+	sorted str.Sequential[V],
+	cycle str.Sequential[V],
+) {
+	// Count the edges leading to each node.
+	var nodes = v.edges_.GetKeys().AsArray()
+	var inDegrees = make(map[V]int, len(nodes))
+	for _, node := range nodes {
+		for _, successor := range v.successorsOf(node) {
+			inDegrees[successor]++
+		}
+	}
+
+	// Repeatedly remove the nodes that have no remaining edges leading to them.
+	var ordered = make([]V, 0, len(nodes))
+	for _, node := range nodes {
+		if inDegrees[node] == 0 {
+			ordered = append(ordered, node)
+		}
+	}
+	for next := 0; next < len(ordered); next++ {
+		for _, successor := range v.successorsOf(ordered[next]) {
+			inDegrees[successor]--
+			if inDegrees[successor] == 0 {
+				ordered = append(ordered, successor)
+			}
+		}
+	}
+
+	var listClass = ListClass[V]()
+	if len(ordered) < len(nodes) {
+		// The remaining nodes are part of, or depend on, a cycle.
+		sorted = listClass.List()
+		cycle = listClass.ListFromArray(v.findCycle())
+		return
+	}
+	sorted = listClass.ListFromArray(ordered)
+	cycle = listClass.List()
+	return
+}
+
+func (v *graph_[V]) GetShortestPath(
+	source V,
+	target V,
+) str.Sequential[V] {
+	var listClass = ListClass[V]()
+	if !v.ContainsNode(source) || !v.ContainsNode(target) {
+		return listClass.List()
+	}
+
+	// Perform a breadth first search recording the node each node was reached
+	// from.
+	var previous = map[V]V{}
+	var visited = map[V]bool{source: true}
+	var nodes = []V{source}
+	for next := 0; next < len(nodes) && !visited[target]; next++ {
+		var node = nodes[next]
+		for _, successor := range v.successorsOf(node) {
+			if !visited[successor] {
+				visited[successor] = true
+				previous[successor] = node
+				nodes = append(nodes, successor)
+			}
+		}
+	}
+	if !visited[target] {
+		// The target cannot be reached from the source.
+		return listClass.List()
+	}
+
+	// Walk the path backwards from the target to the source.
+	var path = []V{target}
+	for node := target; node != source; {
+		node = previous[node]
+		path = append(path, node)
+	}
+	slc.Reverse(path)
+	return listClass.ListFromArray(path)
+}
+
+// Attribute Methods
+
+// str.Sequential[V] Methods
+
+func (v *graph_[V]) IsEmpty() bool {
+	return v.edges_.IsEmpty()
+}
+
+func (v *graph_[V]) GetSize() uint {
+	return v.edges_.GetSize()
+}
+
+func (v *graph_[V]) AsArray() []V {
+	return v.edges_.GetKeys().AsArray()
+}
+
+func (v *graph_[V]) GetIterator() age.IteratorLike[V] {
+	var iteratorClass = age.IteratorClass[V]()
+	var iterator = iteratorClass.Iterator(v.AsArray())
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *graph_[V]) String() string {
+	return fmt.Sprintf("%v", v.AsCatalog())
+}
+
+func (v *graph_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsCatalog())
+}
+
+func (v *graph_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var class = collectionParserClass()
+	var names, members, err = class.decodeMembers(bytes)
+	if err != nil {
+		return err
+	}
+	var nodes = make([]V, len(names))
+	var successors = make([][]V, len(members))
+	for index, name := range names {
+		err = class.decodeKey(name, &nodes[index])
+		if err != nil {
+			return err
+		}
+		err = class.decodeValue(members[index], &successors[index])
+		if err != nil {
+			return err
+		}
+	}
+	v.edges_ = CatalogClass[V, SetLike[V]]().Catalog()
+	for _, node := range nodes {
+		v.AddNode(node)
+	}
+	for index, node := range nodes {
+		for _, successor := range successors[index] {
+			v.AddEdge(node, successor)
+		}
+	}
+	return nil
+}
+
+// Private Methods
+
+// This private instance method returns the successors of the specified node in
+// their collated order, or an empty array if the node is not in the graph.
+func (v *graph_[V]) successorsOf(
+	node V,
+) []V {
+	var successors = v.edges_.GetValue(node)
+	if successors == nil {
+		return []V{}
+	}
+	return successors.AsArray()
+}
+
+// This private instance method performs a depth first search of the graph for a
+// cycle.  If one is found it returns the nodes in the cycle starting and ending
+// with the same node, otherwise it returns nil.
+func (v *graph_[V]) findCycle() []V {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	var states = map[V]int{}
+	var path []V
+	var visit func(node V) []V
+	visit = func(node V) []V {
+		states[node] = visiting
+		path = append(path, node)
+		for _, successor := range v.successorsOf(node) {
+			switch states[successor] {
+			case visiting:
+				// The successor is already on the current path.
+				var start = len(path) - 1
+				for path[start] != successor {
+					start--
+				}
+				var cycle = append([]V{}, path[start:]...)
+				return append(cycle, successor)
+			case unvisited:
+				var cycle = visit(successor)
+				if cycle != nil {
+					return cycle
+				}
+			}
+		}
+		states[node] = visited
+		path = path[:len(path)-1]
+		return nil
+	}
+	var iterator = v.edges_.GetKeys().GetIterator()
+	for iterator.HasNext() {
+		var node = iterator.GetNext()
+		if states[node] == unvisited {
+			var cycle = visit(node)
+			if cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// Instance Structure
+
+type graph_[V comparable] struct {
+	// Declare the instance attributes.
+	edges_ CatalogLike[V, SetLike[V]]
+}
+
+// Class Structure
+
+type graphClass_[V comparable] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var graphMap_ = map[string]any{}
+var graphMutex_ syn.Mutex
+
+func graphClass[V comparable]() *graphClass_[V] {
+	// Generate the name of the bound class type.
+	var class *graphClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	graphMutex_.Lock()
+	var value = graphMap_[name]
+	switch actual := value.(type) {
+	case *graphClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &graphClass_[V]{
+			// Initialize the class constants.
+		}
+		graphMap_[name] = class
+	}
+	graphMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
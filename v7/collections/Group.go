@@ -0,0 +1,261 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	ctx "context"
+	fmt "fmt"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func GroupClass() GroupClassLike {
+	return groupClass()
+}
+
+// Constructor Methods
+
+func (c *groupClass_) Group() GroupLike {
+	var instance = c.GroupWithContext(ctx.Background())
+	return instance
+}
+
+func (c *groupClass_) GroupWithContext(
+	context ctx.Context,
+) GroupLike {
+	if uti.IsUndefined(context) {
+		panic("The \"context\" attribute is required by this class.")
+	}
+	var instance = &group_{
+		// Initialize the instance attributes.
+	}
+	instance.context_, instance.cancel_ = ctx.WithCancelCause(context)
+	instance.stop_ = ctx.AfterFunc(instance.context_, instance.tearDown)
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *group_) GetClass() GroupClassLike {
+	return groupClass()
+}
+
+func (v *group_) GoWithError(
+	function func() error,
+) {
+	v.waitGroup_.Go(func() {
+		defer func() {
+			var e = recover()
+			if e != nil {
+				v.fail(fmt.Errorf("A go-routine in the group panicked: %v", e))
+			}
+		}()
+		var err = function()
+		if err != nil {
+			v.fail(err)
+		}
+	})
+}
+
+func (v *group_) ConnectChannel(
+	channel Closable,
+) {
+	v.mutex_.Lock()
+	var failed = v.context_.Err() != nil
+	if !failed {
+		v.channels_ = append(v.channels_, channel)
+	}
+	v.mutex_.Unlock()
+	if failed {
+		// The group has already been torn down.
+		channel.CloseChannel()
+	}
+}
+
+func (v *group_) WaitForError() error {
+	v.waitGroup_.Wait()
+	v.finish()
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	if len(v.errors_) == 0 {
+		return nil
+	}
+	return v.errors_[0]
+}
+
+func (v *group_) GetErrors() []error {
+	v.mutex_.Lock()
+	var errors = append([]error(nil), v.errors_...)
+	v.mutex_.Unlock()
+	return errors
+}
+
+// Attribute Methods
+
+func (v *group_) GetContext() ctx.Context {
+	return v.context_
+}
+
+// Synchronized Methods
+
+func (v *group_) Go(
+	function func(),
+) {
+	v.GoWithError(func() error {
+		function()
+		return nil
+	})
+}
+
+func (v *group_) Wait() {
+	v.waitGroup_.Wait()
+	v.finish()
+}
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// This private class method connects the specified channels to the specified
+// group if it is a group-like instance, so that they are closed when the group
+// is torn down.
+func (c *groupClass_) connectChannels(
+	group Synchronized,
+	channels ...Closable,
+) {
+	var actual, ok = group.(GroupLike)
+	if !ok {
+		return
+	}
+	for _, channel := range channels {
+		actual.ConnectChannel(channel)
+	}
+}
+
+// This private class method returns the context for the specified group if it
+// is a group-like instance, otherwise it returns a context that is never
+// cancelled.
+func (c *groupClass_) contextOf(
+	group Synchronized,
+) ctx.Context {
+	var actual, ok = group.(GroupLike)
+	if !ok {
+		return ctx.Background()
+	}
+	return actual.GetContext()
+}
+
+// This private instance method is called once all of the go-routines in the
+// group have finished.  If the group has been torn down it makes sure that the
+// tear down has completed before any errors are read.  Otherwise it stops
+// watching the parent context, releases the connected channels without closing
+// them and then cancels the context without recording an error.
+func (v *group_) finish() {
+	if v.context_.Err() == nil && v.stop_() {
+		v.once_.Do(v.releaseChannels) // The group is never torn down now.
+		v.cancel_(nil)
+		return
+	}
+	if v.context_.Err() != nil {
+		v.tearDown() // Wait for the tear down to complete.
+	}
+}
+
+// This private instance method records the specified error and tears down the
+// group if it is the first error.
+func (v *group_) fail(
+	err error,
+) {
+	v.mutex_.Lock()
+	v.errors_ = append(v.errors_, err)
+	v.mutex_.Unlock()
+	v.cancel_(err) // This has no effect if the group is already cancelled.
+	v.tearDown()   // Make sure the group is torn down before returning.
+}
+
+// This private instance method is called once the context for the group is
+// cancelled.  It records the cause of the cancellation if no go-routine has
+// failed and closes all of the connected channels.  It only takes effect the
+// first time it is called.
+func (v *group_) tearDown() {
+	v.once_.Do(v.closeChannels)
+}
+
+// This private instance method closes all of the connected channels.
+func (v *group_) closeChannels() {
+	v.mutex_.Lock()
+	if len(v.errors_) == 0 {
+		// The parent context was cancelled.
+		v.errors_ = append(v.errors_, ctx.Cause(v.context_))
+	}
+	var channels = v.channels_
+	v.channels_ = nil
+	v.mutex_.Unlock()
+	for _, channel := range channels {
+		channel.CloseChannel()
+	}
+}
+
+// This private instance method releases all of the connected channels without
+// closing them.
+func (v *group_) releaseChannels() {
+	v.mutex_.Lock()
+	v.channels_ = nil
+	v.mutex_.Unlock()
+}
+
+// Instance Structure
+
+// NOTE:
+// The context for a group is cancelled, with the first error as its cause, as
+// soon as any of its go-routines fails.  Its connected channels are closed once
+// the context is cancelled so that any go-routines waiting on them are released.
+// The stop function removes the tear down from the context once the group has
+// finished successfully, and the context is then cancelled to release it.
+type group_ struct {
+	// Declare the instance attributes.
+	cancel_    ctx.CancelCauseFunc
+	channels_  []Closable
+	context_   ctx.Context
+	errors_    []error
+	mutex_     syn.Mutex
+	once_      syn.Once
+	stop_      func() bool
+	waitGroup_ syn.WaitGroup
+}
+
+// Class Structure
+
+type groupClass_ struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+func groupClass() *groupClass_ {
+	return groupClassReference_
+}
+
+var groupClassReference_ = &groupClass_{
+	// Initialize the class constants.
+}
@@ -0,0 +1,491 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	slc "slices"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func HierarchyClass[V any]() HierarchyClassLike[V] {
+	return hierarchyClass[V]()
+}
+
+// Constructor Methods
+
+func (c *hierarchyClass_[V]) Hierarchy() HierarchyLike[V] {
+	var instance = &hierarchy_[V]{
+		// Initialize the instance attributes.
+		root_: c.node(),
+	}
+	return instance
+}
+
+func (c *hierarchyClass_[V]) HierarchyFromArray(
+	associations []AssociationLike[str.NameLike, V],
+) HierarchyLike[V] {
+	var hierarchy = c.Hierarchy()
+	for _, association := range associations {
+		var path = association.GetKey()
+		var value = association.GetValue()
+		hierarchy.SetValue(path, value)
+	}
+	return hierarchy
+}
+
+func (c *hierarchyClass_[V]) HierarchyFromSequence(
+	associations str.Sequential[AssociationLike[str.NameLike, V]],
+) HierarchyLike[V] {
+	var hierarchy = c.Hierarchy()
+	var iterator = associations.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var path = association.GetKey()
+		var value = association.GetValue()
+		hierarchy.SetValue(path, value)
+	}
+	return hierarchy
+}
+
+func (c *hierarchyClass_[V]) HierarchyFromString(
+	source string,
+) HierarchyLike[V] {
+	var associations = collectionParserClass().parseCatalog(source)
+	var hierarchy = c.Hierarchy()
+	var iterator = associations.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var path, pathOk = association.GetKey().(str.NameLike)
+		var value, valueOk = association.GetValue().(V)
+		if !pathOk || !valueOk {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the hierarchy constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		hierarchy.SetValue(path, value)
+	}
+	return hierarchy
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *hierarchy_[V]) GetClass() HierarchyClassLike[V] {
+	return hierarchyClass[V]()
+}
+
+func (v *hierarchy_[V]) ContainsPath(
+	path str.NameLike,
+) bool {
+	var node = v.findNode(path.AsIntrinsic())
+	return node != nil && node.defined_
+}
+
+func (v *hierarchy_[V]) GetChildren(
+	path str.NameLike,
+) str.Sequential[str.NameLike] {
+	var children = ListClass[str.NameLike]().List()
+	var identifiers = path.AsIntrinsic()
+	var node = v.findNode(identifiers)
+	if node == nil {
+		return children
+	}
+	var nameClass = str.NameClass()
+	var iterator = node.children_.GetKeys().GetIterator()
+	for iterator.HasNext() {
+		var child = append(slc.Clip(identifiers), iterator.GetNext())
+		children.AppendValue(nameClass.Name(child))
+	}
+	return children
+}
+
+func (v *hierarchy_[V]) GetSubtree(
+	path str.NameLike,
+) HierarchyLike[V] {
+	var class = hierarchyClass[V]()
+	var subtree = class.Hierarchy()
+	var iterator = v.WalkPrefix(path)
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		subtree.SetValue(association.GetKey(), association.GetValue())
+	}
+	return subtree
+}
+
+func (v *hierarchy_[V]) RemoveSubtree(
+	path str.NameLike,
+) HierarchyLike[V] {
+	var subtree = v.GetSubtree(path)
+	var identifiers = path.AsIntrinsic()
+	if len(identifiers) == 0 {
+		// The whole hierarchy is being removed.
+		v.RemoveAll()
+		return subtree
+	}
+	var parent = v.findNode(identifiers[:len(identifiers)-1])
+	if parent != nil && parent.children_.RemoveValue(identifiers[len(identifiers)-1]) != nil {
+		v.size_ -= subtree.GetSize()
+		v.prune(identifiers[:len(identifiers)-1])
+	}
+	return subtree
+}
+
+func (v *hierarchy_[V]) MoveSubtree(
+	source str.NameLike,
+	target str.NameLike,
+) bool {
+	var sourceIdentifiers = source.AsIntrinsic()
+	var targetIdentifiers = target.AsIntrinsic()
+	var size = len(sourceIdentifiers)
+	switch {
+	case size == 0 || len(targetIdentifiers) == 0:
+		// The root of the hierarchy cannot be moved or replaced.
+		return false
+	case len(targetIdentifiers) >= size &&
+		slc.Equal(targetIdentifiers[:size], sourceIdentifiers):
+		// A subtree cannot be moved beneath itself.
+		return false
+	case v.findNode(sourceIdentifiers) == nil || v.findNode(targetIdentifiers) != nil:
+		return false
+	}
+
+	// Detach the subtree from its current parent.
+	var parent = v.findNode(sourceIdentifiers[:size-1])
+	var node = parent.children_.RemoveValue(sourceIdentifiers[size-1])
+	v.prune(sourceIdentifiers[:size-1])
+
+	// Attach the subtree to its new parent.
+	size = len(targetIdentifiers)
+	parent = v.makeNode(targetIdentifiers[:size-1])
+	parent.children_.SetValue(targetIdentifiers[size-1], node)
+	return true
+}
+
+func (v *hierarchy_[V]) WalkPrefix(
+	prefix str.NameLike,
+) age.IteratorLike[AssociationLike[str.NameLike, V]] {
+	var associations = []AssociationLike[str.NameLike, V]{}
+	var identifiers = prefix.AsIntrinsic()
+	var node = v.findNode(identifiers)
+	if node != nil {
+		associations = v.appendAssociations(associations, identifiers, node)
+	}
+	var iteratorClass = age.IteratorClass[AssociationLike[str.NameLike, V]]()
+	return iteratorClass.Iterator(associations)
+}
+
+// Attribute Methods
+
+// Associative[str.NameLike, V] Methods
+
+func (v *hierarchy_[V]) AsMap() map[str.NameLike]V {
+	var map_ = map[str.NameLike]V{}
+	for _, association := range v.AsArray() {
+		map_[association.GetKey()] = association.GetValue()
+	}
+	return map_
+}
+
+func (v *hierarchy_[V]) GetValue(
+	path str.NameLike,
+) V {
+	var value V // This will be undefined if there is no value at the path.
+	var node = v.findNode(path.AsIntrinsic())
+	if node != nil && node.defined_ {
+		value = node.value_
+	}
+	return value
+}
+
+func (v *hierarchy_[V]) SetValue(
+	path str.NameLike,
+	value V,
+) {
+	var identifiers = path.AsIntrinsic()
+	if len(identifiers) == 0 {
+		panic("A value cannot be stored at the root of a hierarchy.")
+	}
+	var node = v.makeNode(identifiers)
+	if !node.defined_ {
+		node.defined_ = true
+		v.size_++
+	}
+	node.value_ = value
+}
+
+func (v *hierarchy_[V]) GetKeys() str.Sequential[str.NameLike] {
+	var keys = ListClass[str.NameLike]().List()
+	for _, association := range v.AsArray() {
+		keys.AppendValue(association.GetKey())
+	}
+	return keys
+}
+
+func (v *hierarchy_[V]) GetValues(
+	paths str.Sequential[str.NameLike],
+) str.Sequential[V] {
+	var values = ListClass[V]().List()
+	var iterator = paths.GetIterator()
+	for iterator.HasNext() {
+		var path = iterator.GetNext()
+		values.AppendValue(v.GetValue(path))
+	}
+	return values
+}
+
+func (v *hierarchy_[V]) RemoveValue(
+	path str.NameLike,
+) V {
+	var old V // This will be undefined if there is no value at the path.
+	var identifiers = path.AsIntrinsic()
+	var node = v.findNode(identifiers)
+	if node == nil || !node.defined_ {
+		return old
+	}
+	old = node.value_
+	var zero V
+	node.value_ = zero
+	node.defined_ = false
+	v.size_--
+	v.prune(identifiers)
+	return old
+}
+
+func (v *hierarchy_[V]) RemoveValues(
+	paths str.Sequential[str.NameLike],
+) str.Sequential[V] {
+	var values = ListClass[V]().List()
+	var iterator = paths.GetIterator()
+	for iterator.HasNext() {
+		var path = iterator.GetNext()
+		values.AppendValue(v.RemoveValue(path))
+	}
+	return values
+}
+
+func (v *hierarchy_[V]) RemoveAll() {
+	v.root_ = hierarchyClass[V]().node()
+	v.size_ = 0
+}
+
+// str.Sequential[AssociationLike[str.NameLike, V]] Methods
+
+func (v *hierarchy_[V]) IsEmpty() bool {
+	return v.size_ == 0
+}
+
+func (v *hierarchy_[V]) GetSize() uint {
+	return v.size_
+}
+
+func (v *hierarchy_[V]) AsArray() []AssociationLike[str.NameLike, V] {
+	var array = make([]AssociationLike[str.NameLike, V], 0, v.size_)
+	return v.appendAssociations(array, nil, v.root_)
+}
+
+func (v *hierarchy_[V]) GetIterator() age.IteratorLike[AssociationLike[str.NameLike, V]] {
+	var iteratorClass = age.IteratorClass[AssociationLike[str.NameLike, V]]()
+	var iterator = iteratorClass.Iterator(v.AsArray())
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *hierarchy_[V]) String() string {
+	var catalogClass = CatalogClass[str.NameLike, V]()
+	return fmt.Sprintf("%v", catalogClass.CatalogFromArray(v.AsArray()))
+}
+
+func (v *hierarchy_[V]) MarshalJSON() ([]byte, error) {
+	var catalogClass = CatalogClass[str.NameLike, V]()
+	return jsn.Marshal(catalogClass.CatalogFromArray(v.AsArray()))
+}
+
+func (v *hierarchy_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var class = collectionParserClass()
+	var names, members, err = class.decodeMembers(bytes)
+	if err != nil {
+		return err
+	}
+	var paths = make([]str.NameLike, len(names))
+	var values = make([]V, len(members))
+	for index, name := range names {
+		err = class.decodeKey(name, &paths[index])
+		if err != nil {
+			return err
+		}
+		err = class.decodeValue(members[index], &values[index])
+		if err != nil {
+			return err
+		}
+	}
+	v.RemoveAll()
+	for index, path := range paths {
+		v.SetValue(path, values[index])
+	}
+	return nil
+}
+
+// Private Methods
+
+// This private class method returns a new hierarchy node without a value or
+// any children.
+func (c *hierarchyClass_[V]) node() *hierarchyNode_[V] {
+	var catalogClass = CatalogClass[str.Identifier, *hierarchyNode_[V]]()
+	return &hierarchyNode_[V]{
+		children_: catalogClass.Catalog(),
+	}
+}
+
+// This private instance method appends an association for each value at or
+// below the specified node to the specified array in depth first (pre-order)
+// order.  The identifiers specify the path to the node.
+func (v *hierarchy_[V]) appendAssociations(
+	array []AssociationLike[str.NameLike, V],
+	identifiers []str.Identifier,
+	node *hierarchyNode_[V],
+) []AssociationLike[str.NameLike, V] {
+	var associationClass = AssociationClass[str.NameLike, V]()
+	if node.defined_ {
+		var path = str.NameClass().Name(identifiers)
+		array = append(array, associationClass.Association(path, node.value_))
+	}
+	var iterator = node.children_.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var child = append(slc.Clip(identifiers), association.GetKey())
+		array = v.appendAssociations(array, child, association.GetValue())
+	}
+	return array
+}
+
+// This private instance method returns the node at the path specified by the
+// identifiers, or nil if there is no such node.
+func (v *hierarchy_[V]) findNode(
+	identifiers []str.Identifier,
+) *hierarchyNode_[V] {
+	var node = v.root_
+	for _, identifier := range identifiers {
+		node = node.children_.GetValue(identifier)
+		if node == nil {
+			break
+		}
+	}
+	return node
+}
+
+// This private instance method returns the node at the path specified by the
+// identifiers, creating it and any missing nodes above it as needed.
+func (v *hierarchy_[V]) makeNode(
+	identifiers []str.Identifier,
+) *hierarchyNode_[V] {
+	var class = hierarchyClass[V]()
+	var node = v.root_
+	for _, identifier := range identifiers {
+		var child = node.children_.GetValue(identifier)
+		if child == nil {
+			child = class.node()
+			node.children_.SetValue(identifier, child)
+		}
+		node = child
+	}
+	return node
+}
+
+// This private instance method removes the node at the path specified by the
+// identifiers, and each node above it, that no longer has a value or any
+// children.  The root node is never removed.
+func (v *hierarchy_[V]) prune(
+	identifiers []str.Identifier,
+) {
+	for size := len(identifiers); size > 0; size-- {
+		var node = v.findNode(identifiers[:size])
+		if node == nil {
+			continue
+		}
+		if node.defined_ || !node.children_.IsEmpty() {
+			break
+		}
+		var parent = v.findNode(identifiers[:size-1])
+		parent.children_.RemoveValue(identifiers[size-1])
+	}
+}
+
+// This private type defines a node in a hierarchy.  A node that does not have
+// a value is retained only while it has children.
+type hierarchyNode_[V any] struct {
+	children_ CatalogLike[str.Identifier, *hierarchyNode_[V]]
+	defined_  bool
+	value_    V
+}
+
+// Instance Structure
+
+type hierarchy_[V any] struct {
+	// Declare the instance attributes.
+	root_ *hierarchyNode_[V]
+	size_ uint
+}
+
+// Class Structure
+
+type hierarchyClass_[V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var hierarchyMap_ = map[string]any{}
+var hierarchyMutex_ syn.Mutex
+
+func hierarchyClass[V any]() *hierarchyClass_[V] {
+	// Generate the name of the bound class type.
+	var class *hierarchyClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	hierarchyMutex_.Lock()
+	var value = hierarchyMap_[name]
+	switch actual := value.(type) {
+	case *hierarchyClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &hierarchyClass_[V]{
+			// Initialize the class constants.
+		}
+		hierarchyMap_[name] = class
+	}
+	hierarchyMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
@@ -13,10 +13,12 @@
 package collections
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	str "github.com/craterdog/go-component-framework/v7/strings"
 	uti "github.com/craterdog/go-missing-utilities/v7"
+	sts "strings"
 	syn "sync"
 )
 
@@ -55,6 +57,26 @@ func (c *listClass_[V]) ListFromSequence(
 	return instance
 }
 
+func (c *listClass_[V]) ListFromString(
+	source string,
+) ListLike[V] {
+	var values = collectionParserClass().parseSequence(source, "list")
+	var list = c.List()
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value, ok = iterator.GetNext().(V)
+		if !ok {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the list constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		list.AppendValue(value)
+	}
+	return list
+}
+
 // Constant Methods
 
 // Function Methods
@@ -351,7 +373,41 @@ func (v *list_[V]) SetValues(
 // PROTECTED INTERFACE
 
 func (v *list_[V]) String() string {
-	return uti.Format(v)
+	var class = collectionParserClass()
+	var builder sts.Builder
+	builder.WriteString("[")
+	for index, value := range v.array_ {
+		if index > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(class.formatValue(value))
+	}
+	builder.WriteString("]")
+	return builder.String()
+}
+
+func (v *list_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.array_)
+}
+
+func (v *list_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var class = collectionParserClass()
+	var values []jsn.RawMessage
+	var err = jsn.Unmarshal(bytes, &values)
+	if err != nil {
+		return err
+	}
+	var array = make([]V, len(values))
+	for index, value := range values {
+		err = class.decodeValue(value, &array[index])
+		if err != nil {
+			return err
+		}
+	}
+	v.array_ = array
+	return nil
 }
 
 // Private Methods
@@ -0,0 +1,354 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func MultiCatalogClass[K comparable, V any]() MultiCatalogClassLike[K, V] {
+	return multiCatalogClass[K, V]()
+}
+
+// Constructor Methods
+
+func (c *multiCatalogClass_[K, V]) MultiCatalog() MultiCatalogLike[K, V] {
+	var catalogClass = CatalogClass[K, ListLike[V]]()
+	var catalog = catalogClass.Catalog()
+	var instance = &multiCatalog_[K, V]{
+		// Initialize the instance attributes.
+		catalog_: catalog,
+	}
+	return instance
+}
+
+func (c *multiCatalogClass_[K, V]) MultiCatalogFromArray(
+	mappings []AssociationLike[K, V],
+) MultiCatalogLike[K, V] {
+	var multiCatalog = c.MultiCatalog()
+	for _, mapping := range mappings {
+		var key = mapping.GetKey()
+		var value = mapping.GetValue()
+		multiCatalog.AddMapping(key, value)
+	}
+	return multiCatalog
+}
+
+func (c *multiCatalogClass_[K, V]) MultiCatalogFromSequence(
+	mappings str.Sequential[AssociationLike[K, V]],
+) MultiCatalogLike[K, V] {
+	var multiCatalog = c.MultiCatalog()
+	var iterator = mappings.GetIterator()
+	for iterator.HasNext() {
+		var mapping = iterator.GetNext()
+		var key = mapping.GetKey()
+		var value = mapping.GetValue()
+		multiCatalog.AddMapping(key, value)
+	}
+	return multiCatalog
+}
+
+func (c *multiCatalogClass_[K, V]) MultiCatalogFromString(
+	source string,
+) MultiCatalogLike[K, V] {
+	var message = fmt.Sprintf(
+		"An illegal string was passed to the multi-catalog constructor method: %s",
+		source,
+	)
+	var associations = collectionParserClass().parseCatalog(source)
+	var multiCatalog = c.MultiCatalog()
+	var iterator = associations.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var key, keyOk = association.GetKey().(K)
+		var values, valuesOk = association.GetValue().(ListLike[any])
+		if !keyOk || !valuesOk {
+			panic(message)
+		}
+		var valueIterator = values.GetIterator()
+		for valueIterator.HasNext() {
+			var value, ok = valueIterator.GetNext().(V)
+			if !ok {
+				panic(message)
+			}
+			multiCatalog.AddMapping(key, value)
+		}
+	}
+	return multiCatalog
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *multiCatalogClass_[K, V]) Merge(
+	first MultiCatalogLike[K, V],
+	second MultiCatalogLike[K, V],
+) MultiCatalogLike[K, V] {
+	var multiCatalog = c.MultiCatalog()
+	for _, source := range []MultiCatalogLike[K, V]{first, second} {
+		var iterator = source.GetIterator()
+		for iterator.HasNext() {
+			var association = iterator.GetNext()
+			var key = association.GetKey()
+			var values = association.GetValue().GetIterator()
+			for values.HasNext() {
+				multiCatalog.AddMapping(key, values.GetNext())
+			}
+		}
+	}
+	return multiCatalog
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *multiCatalog_[K, V]) GetClass() MultiCatalogClassLike[K, V] {
+	return multiCatalogClass[K, V]()
+}
+
+func (v *multiCatalog_[K, V]) AddMapping(
+	key K,
+	value V,
+) {
+	var values = v.catalog_.GetValue(key)
+	if values == nil {
+		// This is the first value for the key.
+		values = ListClass[V]().List()
+		v.catalog_.SetValue(key, values)
+	}
+	values.AppendValue(value)
+}
+
+func (v *multiCatalog_[K, V]) ContainsMapping(
+	key K,
+	value V,
+) bool {
+	var values = v.catalog_.GetValue(key)
+	return values != nil && values.GetIndex(value) > 0
+}
+
+func (v *multiCatalog_[K, V]) RemoveMapping(
+	key K,
+	value V,
+) {
+	var values = v.catalog_.GetValue(key)
+	if values == nil {
+		return
+	}
+	var index = values.GetIndex(value)
+	if index > 0 {
+		// Remove the first occurrence of the value.
+		values.RemoveValue(index)
+	}
+	if values.IsEmpty() {
+		// The key no longer has any values.
+		v.catalog_.RemoveValue(key)
+	}
+}
+
+func (v *multiCatalog_[K, V]) GetMappingCount() uint {
+	var count uint
+	var iterator = v.catalog_.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		count += association.GetValue().GetSize()
+	}
+	return count
+}
+
+// Attribute Methods
+
+// Associative[K, ListLike[V]] Methods
+
+func (v *multiCatalog_[K, V]) AsMap() map[K]ListLike[V] {
+	return v.catalog_.AsMap()
+}
+
+func (v *multiCatalog_[K, V]) GetValue(
+	key K,
+) ListLike[V] {
+	return v.catalog_.GetValue(key)
+}
+
+func (v *multiCatalog_[K, V]) SetValue(
+	key K,
+	values ListLike[V],
+) {
+	if values == nil || values.IsEmpty() {
+		// A key without any values is not retained.
+		v.catalog_.RemoveValue(key)
+		return
+	}
+	v.catalog_.SetValue(key, values)
+}
+
+func (v *multiCatalog_[K, V]) GetKeys() str.Sequential[K] {
+	return v.catalog_.GetKeys()
+}
+
+func (v *multiCatalog_[K, V]) GetValues(
+	keys str.Sequential[K],
+) str.Sequential[ListLike[V]] {
+	return v.catalog_.GetValues(keys)
+}
+
+func (v *multiCatalog_[K, V]) RemoveValue(
+	key K,
+) ListLike[V] {
+	return v.catalog_.RemoveValue(key)
+}
+
+func (v *multiCatalog_[K, V]) RemoveValues(
+	keys str.Sequential[K],
+) str.Sequential[ListLike[V]] {
+	return v.catalog_.RemoveValues(keys)
+}
+
+func (v *multiCatalog_[K, V]) RemoveAll() {
+	v.catalog_.RemoveAll()
+}
+
+// str.Sequential[AssociationLike[K, ListLike[V]]] Methods
+
+func (v *multiCatalog_[K, V]) IsEmpty() bool {
+	return v.catalog_.IsEmpty()
+}
+
+func (v *multiCatalog_[K, V]) GetSize() uint {
+	return v.catalog_.GetSize()
+}
+
+func (v *multiCatalog_[K, V]) AsArray() []AssociationLike[K, ListLike[V]] {
+	return v.catalog_.AsArray()
+}
+
+func (v *multiCatalog_[K, V]) GetIterator() age.IteratorLike[AssociationLike[K, ListLike[V]]] {
+	return v.catalog_.GetIterator()
+}
+
+// Sortable[AssociationLike[K, ListLike[V]]] Methods
+
+func (v *multiCatalog_[K, V]) SortValues() {
+	v.catalog_.SortValues()
+}
+
+func (v *multiCatalog_[K, V]) SortValuesWithRanker(
+	ranker age.RankingFunction[AssociationLike[K, ListLike[V]]],
+) {
+	v.catalog_.SortValuesWithRanker(ranker)
+}
+
+func (v *multiCatalog_[K, V]) ReverseValues() {
+	v.catalog_.ReverseValues()
+}
+
+func (v *multiCatalog_[K, V]) ShuffleValues() {
+	v.catalog_.ShuffleValues()
+}
+
+// PROTECTED INTERFACE
+
+func (v *multiCatalog_[K, V]) String() string {
+	return fmt.Sprintf("%v", v.catalog_)
+}
+
+func (v *multiCatalog_[K, V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.catalog_)
+}
+
+func (v *multiCatalog_[K, V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var class = collectionParserClass()
+	var names, members, err = class.decodeMembers(bytes)
+	if err != nil {
+		return err
+	}
+	var keys = make([]K, len(names))
+	var values = make([][]V, len(members))
+	for index, name := range names {
+		err = class.decodeKey(name, &keys[index])
+		if err != nil {
+			return err
+		}
+		err = class.decodeValue(members[index], &values[index])
+		if err != nil {
+			return err
+		}
+	}
+	if v.catalog_ == nil {
+		v.catalog_ = CatalogClass[K, ListLike[V]]().Catalog()
+	}
+	v.RemoveAll()
+	for index, key := range keys {
+		for _, value := range values[index] {
+			v.AddMapping(key, value)
+		}
+	}
+	return nil
+}
+
+// Private Methods
+
+// Instance Structure
+
+type multiCatalog_[K comparable, V any] struct {
+	// Declare the instance attributes.
+	catalog_ CatalogLike[K, ListLike[V]]
+}
+
+// Class Structure
+
+type multiCatalogClass_[K comparable, V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var multiCatalogMap_ = map[string]any{}
+var multiCatalogMutex_ syn.Mutex
+
+func multiCatalogClass[K comparable, V any]() *multiCatalogClass_[K, V] {
+	// Generate the name of the bound class type.
+	var class *multiCatalogClass_[K, V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	multiCatalogMutex_.Lock()
+	var value = multiCatalogMap_[name]
+	switch actual := value.(type) {
+	case *multiCatalogClass_[K, V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &multiCatalogClass_[K, V]{
+			// Initialize the class constants.
+		}
+		multiCatalogMap_[name] = class
+	}
+	multiCatalogMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
@@ -0,0 +1,531 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	has "hash/maphash"
+	bts "math/bits"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func PersistentCatalogClass[K comparable, V any]() PersistentCatalogClassLike[K, V] {
+	return persistentCatalogClass[K, V]()
+}
+
+// Constructor Methods
+
+func (c *persistentCatalogClass_[K, V]) PersistentCatalog() PersistentCatalogLike[K, V] {
+	var instance = &persistentCatalog_[K, V]{
+		// Initialize the instance attributes.
+		class_: c,
+	}
+	return instance
+}
+
+func (c *persistentCatalogClass_[K, V]) PersistentCatalogFromArray(
+	associations []AssociationLike[K, V],
+) PersistentCatalogLike[K, V] {
+	var catalog = c.PersistentCatalog()
+	for _, association := range associations {
+		var key = association.GetKey()
+		var value = association.GetValue()
+		catalog = catalog.SetValue(key, value)
+	}
+	return catalog
+}
+
+func (c *persistentCatalogClass_[K, V]) PersistentCatalogFromMap(
+	associations map[K]V,
+) PersistentCatalogLike[K, V] {
+	// The associations are sorted using their "natural" ordering to make this
+	// constructor deterministic (see the CatalogFromMap() constructor).
+	var catalog = catalogClass[K, V]().CatalogFromMap(associations)
+	return c.PersistentCatalogFromSequence(catalog)
+}
+
+func (c *persistentCatalogClass_[K, V]) PersistentCatalogFromSequence(
+	associations str.Sequential[AssociationLike[K, V]],
+) PersistentCatalogLike[K, V] {
+	return c.PersistentCatalogFromArray(associations.AsArray())
+}
+
+func (c *persistentCatalogClass_[K, V]) PersistentCatalogFromString(
+	source string,
+) PersistentCatalogLike[K, V] {
+	var associations = collectionParserClass().parseCatalog(source)
+	var catalog = c.PersistentCatalog()
+	var iterator = associations.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var key, keyOk = association.GetKey().(K)
+		var value, valueOk = association.GetValue().(V)
+		if !keyOk || !valueOk {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the persistent catalog constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		catalog = catalog.SetValue(key, value)
+	}
+	return catalog
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *persistentCatalogClass_[K, V]) Extract(
+	catalog PersistentCatalogLike[K, V],
+	keys str.Sequential[K],
+) PersistentCatalogLike[K, V] {
+	var result = c.PersistentCatalog()
+	var iterator = keys.GetIterator()
+	for iterator.HasNext() {
+		var key = iterator.GetNext()
+		var value = catalog.GetValue(key)
+		result = result.SetValue(key, value)
+	}
+	return result
+}
+
+func (c *persistentCatalogClass_[K, V]) Merge(
+	first PersistentCatalogLike[K, V],
+	second PersistentCatalogLike[K, V],
+) PersistentCatalogLike[K, V] {
+	var catalog = first
+	var iterator = second.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var key = association.GetKey()
+		var value = association.GetValue()
+		catalog = catalog.SetValue(key, value)
+	}
+	return catalog
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *persistentCatalog_[K, V]) GetClass() PersistentCatalogClassLike[K, V] {
+	return v.class_
+}
+
+func (v *persistentCatalog_[K, V]) AsMap() map[K]V {
+	var map_ = map[K]V{}
+	for _, entry := range v.order_.appendValues(nil) {
+		map_[entry.key_] = entry.value_
+	}
+	return map_
+}
+
+func (v *persistentCatalog_[K, V]) GetValue(
+	key K,
+) V {
+	var value V // Set the return value to its zero value.
+	var hash = v.class_.hashKey(key)
+	var sequence, exists = v.keys_.getSequence(hash, key, 0)
+	if exists {
+		var slot, _ = v.order_.findSlot(v.ranker(sequence))
+		value = v.order_.getValue(slot).value_
+	}
+	return value
+}
+
+func (v *persistentCatalog_[K, V]) SetValue(
+	key K,
+	value V,
+) PersistentCatalogLike[K, V] {
+	var hash = v.class_.hashKey(key)
+	var sequence, exists = v.keys_.getSequence(hash, key, 0)
+	if exists {
+		// Replace the value of an existing association.
+		var slot, _ = v.order_.findSlot(v.ranker(sequence))
+		var entry = &entry_[K, V]{
+			sequence_: sequence,
+			key_:      key,
+			value_:    value,
+		}
+		var instance = &persistentCatalog_[K, V]{
+			class_:    v.class_,
+			keys_:     v.keys_,
+			order_:    v.order_.setValue(slot, entry),
+			sequence_: v.sequence_,
+		}
+		return instance
+	}
+
+	// Add a new association at the end of the catalog.
+	var entry = &entry_[K, V]{
+		sequence_: v.sequence_,
+		key_:      key,
+		value_:    value,
+	}
+	var instance = &persistentCatalog_[K, V]{
+		class_:    v.class_,
+		keys_:     v.keys_.withSequence(hash, key, v.sequence_, 0),
+		order_:    v.order_.insertValue(v.order_.getSize(), entry),
+		sequence_: v.sequence_ + 1,
+	}
+	return instance
+}
+
+func (v *persistentCatalog_[K, V]) GetKeys() str.Sequential[K] {
+	var entries = v.order_.appendValues(nil)
+	var keys = make([]K, len(entries))
+	for index, entry := range entries {
+		keys[index] = entry.key_
+	}
+	return persistentListClass[K]().PersistentListFromArray(keys)
+}
+
+func (v *persistentCatalog_[K, V]) GetValues(
+	keys str.Sequential[K],
+) str.Sequential[V] {
+	var values = make([]V, 0, keys.GetSize())
+	var iterator = keys.GetIterator()
+	for iterator.HasNext() {
+		var key = iterator.GetNext()
+		values = append(values, v.GetValue(key))
+	}
+	return persistentListClass[V]().PersistentListFromArray(values)
+}
+
+func (v *persistentCatalog_[K, V]) RemoveValue(
+	key K,
+) PersistentCatalogLike[K, V] {
+	var hash = v.class_.hashKey(key)
+	var sequence, exists = v.keys_.getSequence(hash, key, 0)
+	if !exists {
+		// The key is not in the catalog so nothing changes.
+		return v
+	}
+	var slot, _ = v.order_.findSlot(v.ranker(sequence))
+	var instance = &persistentCatalog_[K, V]{
+		class_:    v.class_,
+		keys_:     v.keys_.withoutKey(hash, key, 0),
+		order_:    v.order_.removeValue(slot),
+		sequence_: v.sequence_,
+	}
+	return instance
+}
+
+func (v *persistentCatalog_[K, V]) RemoveValues(
+	keys str.Sequential[K],
+) PersistentCatalogLike[K, V] {
+	var catalog PersistentCatalogLike[K, V] = v
+	var iterator = keys.GetIterator()
+	for iterator.HasNext() {
+		var key = iterator.GetNext()
+		catalog = catalog.RemoveValue(key)
+	}
+	return catalog
+}
+
+// Attribute Methods
+
+// str.Sequential[AssociationLike[K, V]] Methods
+
+func (v *persistentCatalog_[K, V]) IsEmpty() bool {
+	return v.order_ == nil
+}
+
+func (v *persistentCatalog_[K, V]) GetSize() uint {
+	return v.order_.getSize()
+}
+
+func (v *persistentCatalog_[K, V]) AsArray() []AssociationLike[K, V] {
+	// New associations are created so that changing their values does not
+	// change the persistent catalog.
+	var associationClass = associationClass[K, V]()
+	var entries = v.order_.appendValues(nil)
+	var array = make([]AssociationLike[K, V], len(entries))
+	for index, entry := range entries {
+		array[index] = associationClass.Association(entry.key_, entry.value_)
+	}
+	return array
+}
+
+func (v *persistentCatalog_[K, V]) GetIterator() age.IteratorLike[AssociationLike[K, V]] {
+	var iteratorClass = age.IteratorClass[AssociationLike[K, V]]()
+	var iterator = iteratorClass.Iterator(v.AsArray())
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *persistentCatalog_[K, V]) String() string {
+	var catalog = catalogClass[K, V]().CatalogFromArray(v.AsArray())
+	return fmt.Sprintf("%v", catalog)
+}
+
+func (v *persistentCatalog_[K, V]) MarshalJSON() ([]byte, error) {
+	var catalog = catalogClass[K, V]().CatalogFromArray(v.AsArray())
+	return catalog.(*catalog_[K, V]).MarshalJSON()
+}
+
+// Private Methods
+
+// This private class method returns the hash of the specified key.
+func (c *persistentCatalogClass_[K, V]) hashKey(
+	key K,
+) uint64 {
+	return has.Comparable(c.seed_, key)
+}
+
+// This private instance method returns a function that ranks the entry with
+// the specified sequence number against a candidate entry in the catalog.
+func (v *persistentCatalog_[K, V]) ranker(
+	sequence uint64,
+) func(candidate *entry_[K, V]) age.Rank {
+	return func(candidate *entry_[K, V]) age.Rank {
+		switch {
+		case sequence < candidate.sequence_:
+			return age.LesserRank
+		case sequence > candidate.sequence_:
+			return age.GreaterRank
+		default:
+			return age.EqualRank
+		}
+	}
+}
+
+// NOTE:
+// The keys in a persistent catalog are mapped to the sequence numbers of their
+// associations using a hash array mapped trie (HAMT).  Each level of the trie
+// uses the next five bits of the hash of a key to select one of up to 32 slots.
+// A bitmap records which slots are occupied so that only those slots need be
+// allocated.  Like the nodes of a balanced tree, the nodes of a trie are never
+// modified once they have been created.  A nil trie is an empty trie.  The
+// associations themselves are maintained in a balanced tree that is ordered by
+// their sequence numbers which preserves the order in which they were added.
+
+// This private instance method returns the sequence number associated with the
+// specified key in the trie, if it exists.
+func (v *trie_[K]) getSequence(
+	hash uint64,
+	key K,
+	shift uint,
+) (
+	sequence uint64,
+	exists bool,
+) {
+	var node = v
+	for node != nil {
+		var bit = uint32(1) << ((hash >> shift) & 0x1f)
+		if node.bitmap_&bit == 0 {
+			break
+		}
+		var slot = node.slots_[bts.OnesCount32(node.bitmap_&(bit-1))]
+		if slot.child_ == nil {
+			for _, mapping := range slot.mappings_ {
+				if mapping.hash_ == hash && mapping.key_ == key {
+					return mapping.sequence_, true
+				}
+			}
+			break
+		}
+		node = slot.child_
+		shift += 5
+	}
+	return
+}
+
+// This private instance method returns a new trie that maps the specified key
+// to the specified sequence number.
+func (v *trie_[K]) withSequence(
+	hash uint64,
+	key K,
+	sequence uint64,
+	shift uint,
+) *trie_[K] {
+	var mapping = mapping_[K]{
+		hash_:     hash,
+		key_:      key,
+		sequence_: sequence,
+	}
+	if v == nil {
+		v = &trie_[K]{}
+	}
+	var bit = uint32(1) << ((hash >> shift) & 0x1f)
+	var index = bts.OnesCount32(v.bitmap_ & (bit - 1))
+	if v.bitmap_&bit == 0 {
+		// Add a new slot containing the mapping.
+		var slots = make([]slot_[K], len(v.slots_)+1)
+		copy(slots, v.slots_[:index])
+		slots[index] = slot_[K]{mappings_: []mapping_[K]{mapping}}
+		copy(slots[index+1:], v.slots_[index:])
+		return &trie_[K]{bitmap_: v.bitmap_ | bit, slots_: slots}
+	}
+	var slot = v.slots_[index]
+	switch {
+	case slot.child_ != nil:
+		// Add the mapping to the child trie.
+		slot = slot_[K]{child_: slot.child_.withSequence(hash, key, sequence, shift+5)}
+	case slot.mappings_[0].hash_ == hash:
+		// The keys in the slot all share the same hash.
+		var mappings = make([]mapping_[K], 0, len(slot.mappings_)+1)
+		for _, existing := range slot.mappings_ {
+			if existing.key_ != key {
+				mappings = append(mappings, existing)
+			}
+		}
+		slot = slot_[K]{mappings_: append(mappings, mapping)}
+	default:
+		// Push the existing mappings down into a new child trie.
+		var child = &trie_[K]{
+			bitmap_: uint32(1) << ((slot.mappings_[0].hash_ >> (shift + 5)) & 0x1f),
+			slots_:  []slot_[K]{slot},
+		}
+		slot = slot_[K]{child_: child.withSequence(hash, key, sequence, shift+5)}
+	}
+	var slots = make([]slot_[K], len(v.slots_))
+	copy(slots, v.slots_)
+	slots[index] = slot
+	return &trie_[K]{bitmap_: v.bitmap_, slots_: slots}
+}
+
+// This private instance method returns a new trie that does not contain the
+// specified key.  The key must exist in the trie.
+func (v *trie_[K]) withoutKey(
+	hash uint64,
+	key K,
+	shift uint,
+) *trie_[K] {
+	var bit = uint32(1) << ((hash >> shift) & 0x1f)
+	var index = bts.OnesCount32(v.bitmap_ & (bit - 1))
+	var slot = v.slots_[index]
+	if slot.child_ != nil {
+		var child = slot.child_.withoutKey(hash, key, shift+5)
+		switch {
+		case child == nil:
+			// The child trie is now empty.
+			slot = slot_[K]{}
+		case len(child.slots_) == 1 && child.slots_[0].child_ == nil:
+			// Pull the remaining mappings up into this trie.
+			slot = child.slots_[0]
+		default:
+			slot = slot_[K]{child_: child}
+		}
+	} else {
+		var mappings = make([]mapping_[K], 0, len(slot.mappings_))
+		for _, existing := range slot.mappings_ {
+			if existing.key_ != key {
+				mappings = append(mappings, existing)
+			}
+		}
+		slot = slot_[K]{}
+		if len(mappings) > 0 {
+			slot.mappings_ = mappings
+		}
+	}
+	if slot.child_ == nil && slot.mappings_ == nil {
+		// Remove the empty slot.
+		var bitmap = v.bitmap_ &^ bit
+		if bitmap == 0 {
+			return nil
+		}
+		var slots = make([]slot_[K], len(v.slots_)-1)
+		copy(slots, v.slots_[:index])
+		copy(slots[index:], v.slots_[index+1:])
+		return &trie_[K]{bitmap_: bitmap, slots_: slots}
+	}
+	var slots = make([]slot_[K], len(v.slots_))
+	copy(slots, v.slots_)
+	slots[index] = slot
+	return &trie_[K]{bitmap_: v.bitmap_, slots_: slots}
+}
+
+// This private type defines an immutable node in a hash array mapped trie.
+type trie_[K comparable] struct {
+	bitmap_ uint32
+	slots_  []slot_[K]
+}
+
+// This private type defines an occupied slot in a trie node.  It contains
+// either a child trie or the mappings for the keys whose hashes select the
+// slot.  All keys in the same slot share the same hash.
+type slot_[K comparable] struct {
+	child_    *trie_[K]
+	mappings_ []mapping_[K]
+}
+
+// This private type maps a key to the sequence number of its association.
+type mapping_[K comparable] struct {
+	hash_     uint64
+	key_      K
+	sequence_ uint64
+}
+
+// This private type defines an immutable association in a persistent catalog.
+type entry_[K comparable, V any] struct {
+	sequence_ uint64
+	key_      K
+	value_    V
+}
+
+// Instance Structure
+
+type persistentCatalog_[K comparable, V any] struct {
+	// Declare the instance attributes.
+	class_    *persistentCatalogClass_[K, V]
+	keys_     *trie_[K]
+	order_    *node_[*entry_[K, V]]
+	sequence_ uint64
+}
+
+// Class Structure
+
+type persistentCatalogClass_[K comparable, V any] struct {
+	// Declare the class constants.
+	seed_ has.Seed
+}
+
+// Class Reference
+
+var persistentCatalogMap_ = map[string]any{}
+var persistentCatalogMutex_ syn.Mutex
+
+func persistentCatalogClass[K comparable, V any]() *persistentCatalogClass_[K, V] {
+	// Generate the name of the bound class type.
+	var class *persistentCatalogClass_[K, V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	persistentCatalogMutex_.Lock()
+	var value = persistentCatalogMap_[name]
+	switch actual := value.(type) {
+	case *persistentCatalogClass_[K, V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &persistentCatalogClass_[K, V]{
+			// Initialize the class constants.
+			seed_: has.MakeSeed(),
+		}
+		persistentCatalogMap_[name] = class
+	}
+	persistentCatalogMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
@@ -0,0 +1,595 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func PersistentListClass[V any]() PersistentListClassLike[V] {
+	return persistentListClass[V]()
+}
+
+// Constructor Methods
+
+func (c *persistentListClass_[V]) PersistentList() PersistentListLike[V] {
+	var instance = &persistentList_[V]{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+func (c *persistentListClass_[V]) PersistentListFromArray(
+	values []V,
+) PersistentListLike[V] {
+	var instance = &persistentList_[V]{
+		// Initialize the instance attributes.
+		root_: nodeFromArray(values),
+	}
+	return instance
+}
+
+func (c *persistentListClass_[V]) PersistentListFromSequence(
+	values str.Sequential[V],
+) PersistentListLike[V] {
+	return c.PersistentListFromArray(values.AsArray())
+}
+
+func (c *persistentListClass_[V]) PersistentListFromString(
+	source string,
+) PersistentListLike[V] {
+	var values = collectionParserClass().parseSequence(source, "list")
+	var array = make([]V, 0, values.GetSize())
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value, ok = iterator.GetNext().(V)
+		if !ok {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the persistent list constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		array = append(array, value)
+	}
+	return c.PersistentListFromArray(array)
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *persistentListClass_[V]) Concatenate(
+	first PersistentListLike[V],
+	second PersistentListLike[V],
+) PersistentListLike[V] {
+	return first.AppendValues(second)
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *persistentList_[V]) GetClass() PersistentListClassLike[V] {
+	return persistentListClass[V]()
+}
+
+func (v *persistentList_[V]) InsertValue(
+	slot uint,
+	value V,
+) PersistentListLike[V] {
+	var instance = &persistentList_[V]{
+		root_: v.root_.insertValue(slot, value),
+	}
+	return instance
+}
+
+func (v *persistentList_[V]) InsertValues(
+	slot uint,
+	values str.Sequential[V],
+) PersistentListLike[V] {
+	var root = v.root_
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		root = root.insertValue(slot, value)
+		slot++
+	}
+	var instance = &persistentList_[V]{
+		root_: root,
+	}
+	return instance
+}
+
+func (v *persistentList_[V]) AppendValue(
+	value V,
+) PersistentListLike[V] {
+	return v.InsertValue(v.GetSize(), value)
+}
+
+func (v *persistentList_[V]) AppendValues(
+	values str.Sequential[V],
+) PersistentListLike[V] {
+	var root = v.root_
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		root = root.insertValue(root.getSize(), value)
+	}
+	var instance = &persistentList_[V]{
+		root_: root,
+	}
+	return instance
+}
+
+func (v *persistentList_[V]) SetValue(
+	index int,
+	value V,
+) PersistentListLike[V] {
+	var slot = uti.RelativeToCardinal(index, v.GetSize())
+	var instance = &persistentList_[V]{
+		root_: v.root_.setValue(uint(slot), value),
+	}
+	return instance
+}
+
+func (v *persistentList_[V]) RemoveValue(
+	index int,
+) PersistentListLike[V] {
+	var slot = uti.RelativeToCardinal(index, v.GetSize())
+	var instance = &persistentList_[V]{
+		root_: v.root_.removeValue(uint(slot)),
+	}
+	return instance
+}
+
+func (v *persistentList_[V]) RemoveValues(
+	first int,
+	last int,
+) PersistentListLike[V] {
+	var size = v.GetSize()
+	var goFirst = uti.RelativeToCardinal(first, size)
+	var goLast = uti.RelativeToCardinal(last, size)
+	var root = v.root_
+	for slot := goLast; slot >= goFirst; slot-- {
+		root = root.removeValue(uint(slot))
+	}
+	var instance = &persistentList_[V]{
+		root_: root,
+	}
+	return instance
+}
+
+// Attribute Methods
+
+// str.Accessible[V] Methods
+
+func (v *persistentList_[V]) GetValue(
+	index int,
+) V {
+	var slot = uti.RelativeToCardinal(index, v.GetSize())
+	return v.root_.getValue(uint(slot))
+}
+
+func (v *persistentList_[V]) GetValues(
+	first int,
+	last int,
+) str.Sequential[V] {
+	var size = v.GetSize()
+	var goFirst = uti.RelativeToCardinal(first, size)
+	var goLast = uti.RelativeToCardinal(last, size) + 1
+	var array = v.AsArray()
+	var instance = &persistentList_[V]{
+		root_: nodeFromArray(array[goFirst:goLast]),
+	}
+	return instance
+}
+
+func (v *persistentList_[V]) GetIndex(
+	value V,
+) int {
+	var index int
+	var collatorClass = age.CollatorClass[V]()
+	var compare = collatorClass.Collator().CompareValues
+	var iterator = v.GetIterator()
+	for iterator.HasNext() {
+		index++
+		var candidate = iterator.GetNext()
+		if compare(candidate, value) {
+			// Found the value.
+			return index
+		}
+	}
+	// The value was not found.
+	return 0
+}
+
+// str.Searchable[V] Methods
+
+func (v *persistentList_[V]) ContainsValue(
+	value V,
+) bool {
+	return v.GetIndex(value) > 0
+}
+
+func (v *persistentList_[V]) ContainsAny(
+	values str.Sequential[V],
+) bool {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var candidate = iterator.GetNext()
+		if v.GetIndex(candidate) > 0 {
+			// Found one of the values.
+			return true
+		}
+	}
+	// Did not find any of the values.
+	return false
+}
+
+func (v *persistentList_[V]) ContainsAll(
+	values str.Sequential[V],
+) bool {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var candidate = iterator.GetNext()
+		if v.GetIndex(candidate) == 0 {
+			// One of the values is missing.
+			return false
+		}
+	}
+	// Found all of the values.
+	return true
+}
+
+// str.Sequential[V] Methods
+
+func (v *persistentList_[V]) IsEmpty() bool {
+	return v.root_ == nil
+}
+
+func (v *persistentList_[V]) GetSize() uint {
+	return v.root_.getSize()
+}
+
+func (v *persistentList_[V]) AsArray() []V {
+	var array = make([]V, 0, v.GetSize())
+	return v.root_.appendValues(array)
+}
+
+func (v *persistentList_[V]) GetIterator() age.IteratorLike[V] {
+	// The array is freshly generated from the nodes so it need not be copied.
+	var iteratorClass = age.IteratorClass[V]()
+	var iterator = iteratorClass.Iterator(v.AsArray())
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *persistentList_[V]) String() string {
+	return fmt.Sprintf("%v", listClass[V]().ListFromArray(v.AsArray()))
+}
+
+func (v *persistentList_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsArray())
+}
+
+// Private Methods
+
+// NOTE:
+// The values in a persistent collection are maintained in the nodes of a size
+// balanced AVL tree.  The nodes are never modified once they have been created.
+// Each change to a tree creates new nodes only along the path from the root to
+// the changed node, the remaining nodes are shared with the original tree.  So
+// each change to a collection containing n values requires O[log(n)] time and
+// space and leaves the original collection intact.  A nil node is an empty
+// tree.
+
+// This private function returns a balanced tree containing the specified
+// values in the same order.
+func nodeFromArray[V any](
+	values []V,
+) *node_[V] {
+	var size = len(values)
+	if size == 0 {
+		return nil
+	}
+	var middle = size / 2
+	return newNode(
+		values[middle],
+		nodeFromArray(values[:middle]),
+		nodeFromArray(values[middle+1:]),
+	)
+}
+
+// This private function returns a new node with the specified value and
+// subtrees.
+func newNode[V any](
+	value V,
+	left *node_[V],
+	right *node_[V],
+) *node_[V] {
+	var height = max(left.getHeight(), right.getHeight()) + 1
+	var size = left.getSize() + right.getSize() + 1
+	var node = &node_[V]{
+		value_:  value,
+		left_:   left,
+		right_:  right,
+		height_: height,
+		size_:   size,
+	}
+	return node
+}
+
+// This private function returns a new node with the specified value and
+// subtrees, rotating the subtrees as needed to rebalance the tree.  The heights
+// of the subtrees must differ by no more than two.
+func balancedNode[V any](
+	value V,
+	left *node_[V],
+	right *node_[V],
+) *node_[V] {
+	var leftHeight = left.getHeight()
+	var rightHeight = right.getHeight()
+	switch {
+	case leftHeight > rightHeight+1:
+		if left.left_.getHeight() >= left.right_.getHeight() {
+			// Perform a single right rotation.
+			return newNode(
+				left.value_,
+				left.left_,
+				newNode(value, left.right_, right),
+			)
+		}
+		// Perform a double rotation.
+		var middle = left.right_
+		return newNode(
+			middle.value_,
+			newNode(left.value_, left.left_, middle.left_),
+			newNode(value, middle.right_, right),
+		)
+	case rightHeight > leftHeight+1:
+		if right.right_.getHeight() >= right.left_.getHeight() {
+			// Perform a single left rotation.
+			return newNode(
+				right.value_,
+				newNode(value, left, right.left_),
+				right.right_,
+			)
+		}
+		// Perform a double rotation.
+		var middle = right.left_
+		return newNode(
+			middle.value_,
+			newNode(value, left, middle.left_),
+			newNode(right.value_, middle.right_, right.right_),
+		)
+	default:
+		return newNode(value, left, right)
+	}
+}
+
+func (v *node_[V]) getHeight() int {
+	if v == nil {
+		return 0
+	}
+	return v.height_
+}
+
+func (v *node_[V]) getSize() uint {
+	if v == nil {
+		return 0
+	}
+	return v.size_
+}
+
+// This private instance method returns the value in the specified zero-based
+// slot of the tree.
+func (v *node_[V]) getValue(
+	slot uint,
+) V {
+	var node = v
+	for {
+		var leftSize = node.left_.getSize()
+		switch {
+		case slot < leftSize:
+			node = node.left_
+		case slot > leftSize:
+			slot -= leftSize + 1
+			node = node.right_
+		default:
+			return node.value_
+		}
+	}
+}
+
+// This private instance method returns a new tree with the value in the
+// specified zero-based slot replaced with the specified value.
+func (v *node_[V]) setValue(
+	slot uint,
+	value V,
+) *node_[V] {
+	var leftSize = v.left_.getSize()
+	switch {
+	case slot < leftSize:
+		return newNode(v.value_, v.left_.setValue(slot, value), v.right_)
+	case slot > leftSize:
+		return newNode(v.value_, v.left_, v.right_.setValue(slot-leftSize-1, value))
+	default:
+		return newNode(value, v.left_, v.right_)
+	}
+}
+
+// This private instance method returns a new tree with the specified value
+// inserted into the specified zero-based slot.
+func (v *node_[V]) insertValue(
+	slot uint,
+	value V,
+) *node_[V] {
+	if v == nil {
+		return newNode[V](value, nil, nil)
+	}
+	var leftSize = v.left_.getSize()
+	if slot <= leftSize {
+		return balancedNode(v.value_, v.left_.insertValue(slot, value), v.right_)
+	}
+	return balancedNode(v.value_, v.left_, v.right_.insertValue(slot-leftSize-1, value))
+}
+
+// This private instance method returns a new tree with the value in the
+// specified zero-based slot removed.
+func (v *node_[V]) removeValue(
+	slot uint,
+) *node_[V] {
+	var leftSize = v.left_.getSize()
+	switch {
+	case slot < leftSize:
+		return balancedNode(v.value_, v.left_.removeValue(slot), v.right_)
+	case slot > leftSize:
+		return balancedNode(v.value_, v.left_, v.right_.removeValue(slot-leftSize-1))
+	case v.left_ == nil:
+		return v.right_
+	case v.right_ == nil:
+		return v.left_
+	default:
+		// Replace the value with the first value in the right subtree.
+		var first = v.right_.getValue(0)
+		return balancedNode(first, v.left_, v.right_.removeValue(0))
+	}
+}
+
+// This private instance method searches the tree for a value using the
+// specified ranking function which ranks the value being searched for against
+// each candidate value.  It returns two results:
+//   - slot: The zero-based slot of the value, or if not found, the slot in which
+//     it could be inserted.
+//   - found: A boolean stating whether or not the value was found.
+func (v *node_[V]) findSlot(
+	rank func(candidate V) age.Rank,
+) (
+	slot uint,
+	found bool,
+) {
+	var node = v
+	for node != nil {
+		switch rank(node.value_) {
+		case age.LesserRank:
+			node = node.left_
+		case age.GreaterRank:
+			slot += node.left_.getSize() + 1
+			node = node.right_
+		default:
+			return slot + node.left_.getSize(), true
+		}
+	}
+	return slot, false
+}
+
+// This private instance method appends the values in the tree to the specified
+// array in order.
+func (v *node_[V]) appendValues(
+	array []V,
+) []V {
+	if v == nil {
+		return array
+	}
+	array = v.left_.appendValues(array)
+	array = append(array, v.value_)
+	return v.right_.appendValues(array)
+}
+
+// This private instance method appends, in order, the values in the tree whose
+// zero-based slots lie within the half-open range [first, last) to the specified
+// array.  Only the subtrees that overlap the range are visited.
+func (v *node_[V]) appendRange(
+	first uint,
+	last uint,
+	array []V,
+) []V {
+	if v == nil || first >= last {
+		return array
+	}
+	var leftSize = v.left_.getSize()
+	if first < leftSize {
+		array = v.left_.appendRange(first, min(last, leftSize), array)
+	}
+	if first <= leftSize && leftSize < last {
+		array = append(array, v.value_)
+	}
+	if last > leftSize+1 {
+		var offset = leftSize + 1
+		array = v.right_.appendRange(max(first, offset)-offset, last-offset, array)
+	}
+	return array
+}
+
+// This private type defines an immutable node in a balanced tree.
+type node_[V any] struct {
+	value_  V
+	left_   *node_[V]
+	right_  *node_[V]
+	height_ int
+	size_   uint
+}
+
+// Instance Structure
+
+type persistentList_[V any] struct {
+	// Declare the instance attributes.
+	root_ *node_[V]
+}
+
+// Class Structure
+
+type persistentListClass_[V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var persistentListMap_ = map[string]any{}
+var persistentListMutex_ syn.Mutex
+
+func persistentListClass[V any]() *persistentListClass_[V] {
+	// Generate the name of the bound class type.
+	var class *persistentListClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	persistentListMutex_.Lock()
+	var value = persistentListMap_[name]
+	switch actual := value.(type) {
+	case *persistentListClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &persistentListClass_[V]{
+			// Initialize the class constants.
+		}
+		persistentListMap_[name] = class
+	}
+	persistentListMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
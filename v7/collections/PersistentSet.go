@@ -0,0 +1,386 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	ran "github.com/craterdog/go-component-framework/v7/ranges"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func PersistentSetClass[V any]() PersistentSetClassLike[V] {
+	return persistentSetClass[V]()
+}
+
+// Constructor Methods
+
+func (c *persistentSetClass_[V]) PersistentSet() PersistentSetLike[V] {
+	var collatorClass = age.CollatorClass[V]()
+	var collator = collatorClass.Collator()
+	var instance = c.PersistentSetWithCollator(collator)
+	return instance
+}
+
+func (c *persistentSetClass_[V]) PersistentSetWithCollator(
+	collator age.CollatorLike[V],
+) PersistentSetLike[V] {
+	if uti.IsUndefined(collator) {
+		panic("The \"collator\" attribute is required by this class.")
+	}
+	var instance = &persistentSet_[V]{
+		// Initialize the instance attributes.
+		collator_: collator,
+	}
+	return instance
+}
+
+func (c *persistentSetClass_[V]) PersistentSetFromArray(
+	values []V,
+) PersistentSetLike[V] {
+	var set = c.PersistentSet()
+	for _, value := range values {
+		set = set.AddValue(value)
+	}
+	return set
+}
+
+func (c *persistentSetClass_[V]) PersistentSetFromSequence(
+	values str.Sequential[V],
+) PersistentSetLike[V] {
+	var set = c.PersistentSet()
+	return set.AddValues(values)
+}
+
+func (c *persistentSetClass_[V]) PersistentSetFromString(
+	source string,
+) PersistentSetLike[V] {
+	var values = collectionParserClass().parseSequence(source, "set")
+	var set = c.PersistentSet()
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value, ok = iterator.GetNext().(V)
+		if !ok {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the persistent set constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		set = set.AddValue(value)
+	}
+	return set
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *persistentSetClass_[V]) And(
+	first PersistentSetLike[V],
+	second PersistentSetLike[V],
+) PersistentSetLike[V] {
+	var collator = first.GetCollator()
+	var result = c.PersistentSetWithCollator(collator)
+	var iterator = first.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		if second.ContainsValue(value) {
+			result = result.AddValue(value)
+		}
+	}
+	return result
+}
+
+func (c *persistentSetClass_[V]) Ior(
+	first PersistentSetLike[V],
+	second PersistentSetLike[V],
+) PersistentSetLike[V] {
+	return first.AddValues(second)
+}
+
+func (c *persistentSetClass_[V]) San(
+	first PersistentSetLike[V],
+	second PersistentSetLike[V],
+) PersistentSetLike[V] {
+	return first.RemoveValues(second)
+}
+
+func (c *persistentSetClass_[V]) Xor(
+	first PersistentSetLike[V],
+	second PersistentSetLike[V],
+) PersistentSetLike[V] {
+	return c.Ior(c.San(first, second), c.San(second, first))
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *persistentSet_[V]) GetClass() PersistentSetClassLike[V] {
+	return persistentSetClass[V]()
+}
+
+func (v *persistentSet_[V]) AddValue(
+	value V,
+) PersistentSetLike[V] {
+	var slot, found = v.root_.findSlot(v.ranker(value))
+	if found {
+		// The value is already a member so nothing changes.
+		return v
+	}
+	var instance = &persistentSet_[V]{
+		collator_: v.collator_,
+		root_:     v.root_.insertValue(slot, value),
+	}
+	return instance
+}
+
+func (v *persistentSet_[V]) AddValues(
+	values str.Sequential[V],
+) PersistentSetLike[V] {
+	var set PersistentSetLike[V] = v
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		set = set.AddValue(value)
+	}
+	return set
+}
+
+func (v *persistentSet_[V]) RemoveValue(
+	value V,
+) PersistentSetLike[V] {
+	var slot, found = v.root_.findSlot(v.ranker(value))
+	if !found {
+		// The value is not a member so nothing changes.
+		return v
+	}
+	var instance = &persistentSet_[V]{
+		collator_: v.collator_,
+		root_:     v.root_.removeValue(slot),
+	}
+	return instance
+}
+
+func (v *persistentSet_[V]) RemoveValues(
+	values str.Sequential[V],
+) PersistentSetLike[V] {
+	var set PersistentSetLike[V] = v
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		set = set.RemoveValue(value)
+	}
+	return set
+}
+
+// Attribute Methods
+
+func (v *persistentSet_[V]) GetCollator() age.CollatorLike[V] {
+	return v.collator_
+}
+
+// str.Accessible[V] Methods
+
+func (v *persistentSet_[V]) GetValue(
+	index int,
+) V {
+	var slot = uti.RelativeToCardinal(index, v.GetSize())
+	return v.root_.getValue(uint(slot))
+}
+
+func (v *persistentSet_[V]) GetValues(
+	first int,
+	last int,
+) str.Sequential[V] {
+	var size = v.GetSize()
+	var goFirst = uti.RelativeToCardinal(first, size)
+	var goLast = uti.RelativeToCardinal(last, size) + 1
+	var array = v.AsArray()
+	var values = persistentListClass[V]().PersistentListFromArray(
+		array[goFirst:goLast],
+	)
+	return values
+}
+
+func (v *persistentSet_[V]) GetIndex(
+	value V,
+) int {
+	var slot, found = v.root_.findSlot(v.ranker(value))
+	if !found {
+		return 0
+	}
+	return int(slot) + 1
+}
+
+// Ranged[V] Methods
+
+func (v *persistentSet_[V]) GetValuesWithin(
+	bounds ran.Bounded[V],
+) str.Sequential[V] {
+	var first, last = setClass[V]().slotsWithin(
+		bounds,
+		v.findSlot,
+		v.GetSize(),
+	)
+	var values = persistentListClass[V]().PersistentListFromArray(
+		v.root_.appendRange(first, last, nil),
+	)
+	return values
+}
+
+// str.Searchable[V] Methods
+
+func (v *persistentSet_[V]) ContainsValue(
+	value V,
+) bool {
+	var _, found = v.root_.findSlot(v.ranker(value))
+	return found
+}
+
+func (v *persistentSet_[V]) ContainsAny(
+	values str.Sequential[V],
+) bool {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		if v.ContainsValue(value) {
+			// Found one of the values.
+			return true
+		}
+	}
+	// Did not find any of the values.
+	return false
+}
+
+func (v *persistentSet_[V]) ContainsAll(
+	values str.Sequential[V],
+) bool {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		if !v.ContainsValue(value) {
+			// Didn't find one of the values.
+			return false
+		}
+	}
+	// Found all of the values.
+	return true
+}
+
+// str.Sequential[V] Methods
+
+func (v *persistentSet_[V]) IsEmpty() bool {
+	return v.root_ == nil
+}
+
+func (v *persistentSet_[V]) GetSize() uint {
+	return v.root_.getSize()
+}
+
+func (v *persistentSet_[V]) AsArray() []V {
+	var array = make([]V, 0, v.GetSize())
+	return v.root_.appendValues(array)
+}
+
+func (v *persistentSet_[V]) GetIterator() age.IteratorLike[V] {
+	var iteratorClass = age.IteratorClass[V]()
+	var iterator = iteratorClass.Iterator(v.AsArray())
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *persistentSet_[V]) String() string {
+	return fmt.Sprintf("%v", listClass[V]().ListFromArray(v.AsArray()))
+}
+
+func (v *persistentSet_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsArray())
+}
+
+// Private Methods
+
+// This private instance method returns a function that ranks the specified
+// value against a candidate value in the set.  A collator keeps track of its
+// current depth while ranking values so each search uses its own collator to
+// allow a persistent set to be searched by multiple go-routines at the same
+// time.
+func (v *persistentSet_[V]) ranker(
+	value V,
+) func(candidate V) age.Rank {
+	var collatorClass = age.CollatorClass[V]()
+	var depth = v.collator_.GetMaximumDepth()
+	var collator = collatorClass.CollatorWithMaximumDepth(depth)
+	return func(candidate V) age.Rank {
+		return collator.RankValues(value, candidate)
+	}
+}
+
+// This private instance method returns the zero-based slot of the specified
+// value in the set, or if not found, the slot in which it could be inserted.
+func (v *persistentSet_[V]) findSlot(value V) (slot uint, found bool) {
+	return v.root_.findSlot(v.ranker(value))
+}
+
+// Instance Structure
+
+type persistentSet_[V any] struct {
+	// Declare the instance attributes.
+	collator_ age.CollatorLike[V]
+	root_     *node_[V]
+}
+
+// Class Structure
+
+type persistentSetClass_[V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var persistentSetMap_ = map[string]any{}
+var persistentSetMutex_ syn.Mutex
+
+func persistentSetClass[V any]() *persistentSetClass_[V] {
+	// Generate the name of the bound class type.
+	var class *persistentSetClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	persistentSetMutex_.Lock()
+	var value = persistentSetMap_[name]
+	switch actual := value.(type) {
+	case *persistentSetClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &persistentSetClass_[V]{
+			// Initialize the class constants.
+		}
+		persistentSetMap_[name] = class
+	}
+	persistentSetMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
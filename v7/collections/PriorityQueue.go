@@ -0,0 +1,484 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	syn "sync"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func PriorityQueueClass[V any]() PriorityQueueClassLike[V] {
+	return priorityQueueClass[V]()
+}
+
+// Constructor Methods
+
+func (c *priorityQueueClass_[V]) PriorityQueue() PriorityQueueLike[V] {
+	var ranker = age.CollatorClass[V]().Collator().RankValues
+	var instance = c.PriorityQueueWithRanker(ranker)
+	return instance
+}
+
+func (c *priorityQueueClass_[V]) PriorityQueueWithRanker(
+	ranker age.RankingFunction[V],
+) PriorityQueueLike[V] {
+	if uti.IsUndefined(ranker) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	var instance = &priorityQueue_[V]{
+		// Initialize the instance attributes.
+		ranker_: ranker,
+	}
+	return instance
+}
+
+func (c *priorityQueueClass_[V]) BlockingPriorityQueue(
+	ranker age.RankingFunction[V],
+	capacity uint,
+) PriorityQueueLike[V] {
+	if uti.IsUndefined(ranker) {
+		panic("The \"ranker\" attribute is required by this class.")
+	}
+	if capacity < 1 {
+		capacity = 16 // This is the default capacity.
+	}
+	var instance = &priorityQueue_[V]{
+		// Initialize the instance attributes.
+		capacity_: capacity,
+		ranker_:   ranker,
+	}
+	return instance
+}
+
+func (c *priorityQueueClass_[V]) PriorityQueueFromArray(
+	values []V,
+) PriorityQueueLike[V] {
+	var queue = c.PriorityQueue()
+	for _, value := range values {
+		queue.AddValue(value)
+	}
+	return queue
+}
+
+func (c *priorityQueueClass_[V]) PriorityQueueFromSequence(
+	values str.Sequential[V],
+) PriorityQueueLike[V] {
+	var queue = c.PriorityQueue()
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		queue.AddValue(value)
+	}
+	return queue
+}
+
+func (c *priorityQueueClass_[V]) PriorityQueueFromString(
+	source string,
+) PriorityQueueLike[V] {
+	var values = collectionParserClass().parseSequence(source, "priority queue")
+	var queue = c.PriorityQueue()
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value, ok = iterator.GetNext().(V)
+		if !ok {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the priority queue constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		queue.AddValue(value)
+	}
+	return queue
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *priorityQueue_[V]) GetClass() PriorityQueueClassLike[V] {
+	return priorityQueueClass[V]()
+}
+
+func (v *priorityQueue_[V]) GetFirst() (
+	first V,
+	ok bool,
+) {
+	v.mutex_.Lock()
+	ok = len(v.entries_) > 0
+	if ok {
+		first = v.entries_[0].value_
+	}
+	v.mutex_.Unlock()
+	return
+}
+
+func (v *priorityQueue_[V]) UpdateValue(
+	old V,
+	updated V,
+) bool {
+	var compare = age.CollatorClass[V]().Collator().CompareValues
+	v.mutex_.Lock()
+	defer v.mutex_.Unlock()
+	for index, entry := range v.entries_ {
+		if compare(entry.value_, old) {
+			// The entry keeps its sequence number so it is not moved behind
+			// other entries with the same rank.
+			v.entries_[index].value_ = updated
+			v.siftDown(v.siftUp(index))
+			return true
+		}
+	}
+	return false
+}
+
+// Attribute Methods
+
+func (v *priorityQueue_[V]) GetRanker() age.RankingFunction[V] {
+	return v.ranker_
+}
+
+func (v *priorityQueue_[V]) GetCapacity() uint {
+	v.mutex_.Lock()
+	var capacity = v.capacity_
+	v.mutex_.Unlock()
+	return capacity
+}
+
+// Fifo[V] Methods
+
+func (v *priorityQueue_[V]) AddValue(
+	value V,
+) {
+	v.mutex_.Lock()
+	v.awaitRoom() // Will block when full.
+	var entry = priorityEntry_[V]{value_: value, sequence_: v.sequence_}
+	v.sequence_++
+	v.entries_ = append(v.entries_, entry)
+	v.siftUp(len(v.entries_) - 1)
+	v.wake(&v.notEmpty_)
+	v.mutex_.Unlock()
+}
+
+func (v *priorityQueue_[V]) RemoveFirst() (
+	first V,
+	ok bool,
+) {
+	v.mutex_.Lock()
+	ok = v.awaitValue() // Will block when empty.
+	if ok {
+		var last = len(v.entries_) - 1
+		first = v.entries_[0].value_
+		v.entries_[0] = v.entries_[last]
+		v.entries_[last] = priorityEntry_[V]{} // Allow the value to be garbage collected.
+		v.entries_ = v.entries_[:last]
+		v.siftDown(0)
+		v.wake(&v.notFull_)
+	}
+	v.mutex_.Unlock()
+	return
+}
+
+func (v *priorityQueue_[V]) RemoveAll() {
+	v.mutex_.Lock()
+	v.entries_ = nil
+	v.wake(&v.notFull_)
+	v.mutex_.Unlock()
+}
+
+func (v *priorityQueue_[V]) CloseChannel() {
+	v.mutex_.Lock()
+	if v.capacity_ > 0 {
+		v.closed_ = true
+		// No more values can be placed on the queue.
+		v.wake(&v.notEmpty_)
+		v.wake(&v.notFull_)
+	}
+	v.mutex_.Unlock()
+}
+
+// str.Sequential[V] Methods
+
+func (v *priorityQueue_[V]) IsEmpty() bool {
+	v.mutex_.Lock()
+	var result = len(v.entries_) == 0
+	v.mutex_.Unlock()
+	return result
+}
+
+func (v *priorityQueue_[V]) GetSize() uint {
+	v.mutex_.Lock()
+	var size = uint(len(v.entries_))
+	v.mutex_.Unlock()
+	return size
+}
+
+func (v *priorityQueue_[V]) AsArray() []V {
+	// The values are removed from a copy of the heap in priority order.
+	v.mutex_.Lock()
+	var copy_ = &priorityQueue_[V]{
+		entries_: append([]priorityEntry_[V](nil), v.entries_...),
+		ranker_:  v.ranker_,
+	}
+	v.mutex_.Unlock()
+	var array = make([]V, 0, len(copy_.entries_))
+	for len(copy_.entries_) > 0 {
+		var first, _ = copy_.RemoveFirst()
+		array = append(array, first)
+	}
+	return array
+}
+
+func (v *priorityQueue_[V]) GetIterator() age.IteratorLike[V] {
+	var iteratorClass = age.IteratorClass[V]()
+	var iterator = iteratorClass.Iterator(v.AsArray())
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *priorityQueue_[V]) String() string {
+	return fmt.Sprintf("%v", ListClass[V]().ListFromArray(v.AsArray()))
+}
+
+func (v *priorityQueue_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsArray())
+}
+
+func (v *priorityQueue_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var values = ListClass[V]().List()
+	var err = jsn.Unmarshal(bytes, values)
+	if err != nil {
+		return err
+	}
+	v.mutex_.Lock()
+	if uti.IsUndefined(v.ranker_) {
+		v.ranker_ = age.CollatorClass[V]().Collator().RankValues
+	}
+	var size = values.GetSize()
+	if v.capacity_ > 0 && size > v.capacity_ {
+		v.capacity_ = size
+	}
+	v.entries_ = nil
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var entry = priorityEntry_[V]{value_: iterator.GetNext(), sequence_: v.sequence_}
+		v.sequence_++
+		v.entries_ = append(v.entries_, entry)
+		v.siftUp(len(v.entries_) - 1)
+	}
+	v.wake(&v.notEmpty_)
+	v.mutex_.Unlock()
+	return nil
+}
+
+// Private Methods
+
+// This private instance method waits until there is room for another value in
+// a blocking priority queue.  It panics if the priority queue has been closed.
+// A non-blocking priority queue never waits.  The mutex must be locked when it
+// is called and is locked again when it returns.
+func (v *priorityQueue_[V]) awaitRoom() {
+	for v.capacity_ > 0 {
+		switch {
+		case v.closed_:
+			v.mutex_.Unlock()
+			panic("A value cannot be added to a closed priority queue.")
+		case uint(len(v.entries_)) < v.capacity_:
+			return
+		}
+
+		// Wait for a value to be removed from the priority queue.
+		var signal = v.signal(&v.notFull_)
+		v.mutex_.Unlock()
+		<-signal
+		v.mutex_.Lock()
+	}
+}
+
+// This private instance method waits until a value is available in a blocking
+// priority queue.  It returns false if the priority queue is empty and either
+// does not block or has been closed.  The mutex must be locked when it is
+// called and is locked again when it returns.
+func (v *priorityQueue_[V]) awaitValue() bool {
+	for len(v.entries_) == 0 {
+		if v.capacity_ == 0 || v.closed_ {
+			return false
+		}
+
+		// Wait for a value to be added to the priority queue.
+		var signal = v.signal(&v.notEmpty_)
+		v.mutex_.Unlock()
+		<-signal
+		v.mutex_.Lock()
+	}
+	return true
+}
+
+// This private instance method determines whether or not the entry at the first
+// index in the heap must be removed before the entry at the second index.
+// Entries with equal ranks are removed in the order they were added.
+func (v *priorityQueue_[V]) precedes(
+	first int,
+	second int,
+) bool {
+	var firstEntry = v.entries_[first]
+	var secondEntry = v.entries_[second]
+	switch v.ranker_(firstEntry.value_, secondEntry.value_) {
+	case age.LesserRank:
+		return true
+	case age.GreaterRank:
+		return false
+	default:
+		return firstEntry.sequence_ < secondEntry.sequence_
+	}
+}
+
+// This private instance method moves the entry at the specified index up the
+// heap until its parent precedes it and returns its new index.
+func (v *priorityQueue_[V]) siftUp(
+	index int,
+) int {
+	for index > 0 {
+		var parent = (index - 1) / 2
+		if !v.precedes(index, parent) {
+			break
+		}
+		v.entries_[index], v.entries_[parent] = v.entries_[parent], v.entries_[index]
+		index = parent
+	}
+	return index
+}
+
+// This private instance method moves the entry at the specified index down the
+// heap until it precedes both of its children.
+func (v *priorityQueue_[V]) siftDown(
+	index int,
+) {
+	var size = len(v.entries_)
+	for {
+		var first = index
+		var left = 2*index + 1
+		var right = left + 1
+		if left < size && v.precedes(left, first) {
+			first = left
+		}
+		if right < size && v.precedes(right, first) {
+			first = right
+		}
+		if first == index {
+			break
+		}
+		v.entries_[index], v.entries_[first] = v.entries_[first], v.entries_[index]
+		index = first
+	}
+}
+
+// This private instance method returns the specified signal channel, creating
+// it if no other go-routine is already waiting on it.  The mutex must be locked
+// when it is called.
+func (v *priorityQueue_[V]) signal(
+	signal *chan struct{},
+) <-chan struct{} {
+	if *signal == nil {
+		*signal = make(chan struct{})
+	}
+	return *signal
+}
+
+// This private instance method wakes up all go-routines waiting on the
+// specified signal channel, if any, by closing it.  The mutex must be locked
+// when it is called.
+func (v *priorityQueue_[V]) wake(
+	signal *chan struct{},
+) {
+	if *signal != nil {
+		close(*signal)
+		*signal = nil
+	}
+}
+
+// This private type defines an entry in the heap of a priority queue.  The
+// sequence number records the order in which the values were added.
+type priorityEntry_[V any] struct {
+	value_    V
+	sequence_ uint64
+}
+
+// Instance Structure
+
+// NOTE:
+// Only a blocking priority queue has a capacity.  Its signal channels are only
+// created while go-routines are waiting for a value to be added (notEmpty) or
+// removed (notFull) and are closed to wake all of them up.
+type priorityQueue_[V any] struct {
+	// Declare the instance attributes.
+	capacity_ uint
+	closed_   bool
+	entries_  []priorityEntry_[V]
+	mutex_    syn.Mutex
+	notEmpty_ chan struct{}
+	notFull_  chan struct{}
+	ranker_   age.RankingFunction[V]
+	sequence_ uint64
+}
+
+// Class Structure
+
+type priorityQueueClass_[V any] struct {
+	// Declare the class constants.
+}
+
+// Class Reference
+
+var priorityQueueMap_ = map[string]any{}
+var priorityQueueMutex_ syn.Mutex
+
+func priorityQueueClass[V any]() *priorityQueueClass_[V] {
+	// Generate the name of the bound class type.
+	var class *priorityQueueClass_[V]
+	var name = fmt.Sprintf("%T", class)
+
+	// Check for an existing bound class type.
+	priorityQueueMutex_.Lock()
+	var value = priorityQueueMap_[name]
+	switch actual := value.(type) {
+	case *priorityQueueClass_[V]:
+		// This bound class type already exists.
+		class = actual
+	default:
+		// Add a new bound class type.
+		class = &priorityQueueClass_[V]{
+			// Initialize the class constants.
+		}
+		priorityQueueMap_[name] = class
+	}
+	priorityQueueMutex_.Unlock()
+
+	// Return a reference to the bound class type.
+	return class
+}
@@ -13,11 +13,16 @@
 package collections
 
 import (
+	ctx "context"
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
+	ele "github.com/craterdog/go-component-framework/v7/elements"
 	str "github.com/craterdog/go-component-framework/v7/strings"
 	uti "github.com/craterdog/go-missing-utilities/v7"
+	slc "slices"
 	syn "sync"
+	tim "time"
 )
 
 // CLASS INTERFACE
@@ -41,14 +46,9 @@ func (c *queueClass_[V]) QueueWithCapacity(
 	if capacity < 1 {
 		capacity = 16 // This is the default capacity.
 	}
-	var available = make(chan bool, capacity)
-	var listClass = ListClass[V]()
-	var values = listClass.List()
 	var instance = &queue_[V]{
 		// Initialize the instance attributes.
-		available_: available,
-		capacity_:  capacity,
-		values_:    values,
+		capacity_: capacity,
 	}
 	return instance
 }
@@ -75,6 +75,30 @@ func (c *queueClass_[V]) QueueFromSequence(
 	return queue
 }
 
+func (c *queueClass_[V]) QueueFromString(
+	source string,
+) QueueLike[V] {
+	var values = collectionParserClass().parseSequence(source, "queue")
+	var queue = c.Queue()
+	if values.GetSize() > queue.GetCapacity() {
+		// Make sure that adding the values will not block.
+		queue = c.QueueWithCapacity(values.GetSize())
+	}
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value, ok = iterator.GetNext().(V)
+		if !ok {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the queue constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		queue.AddValue(value)
+	}
+	return queue
+}
+
 // Constant Methods
 
 // Function Methods
@@ -97,8 +121,11 @@ func (c *queueClass_[V]) Fork(
 	var outputs = listClass.List()
 	var counter uint
 	for ; counter < size; counter++ {
-		outputs.AppendValue(c.QueueWithCapacity(capacity))
+		var output = c.QueueWithCapacity(capacity)
+		groupClass().connectChannels(group, output)
+		outputs.AppendValue(output)
 	}
+	var context = groupClass().contextOf(group)
 
 	// Connect up the input queue to the output queues in a separate go-routine.
 	group.Go(func() {
@@ -106,16 +133,19 @@ func (c *queueClass_[V]) Fork(
 		var iterator = outputs.GetIterator()
 		for {
 			// Read from the input queue.
-			var value, ok = input.RemoveFirst() // Will block when empty.
+			var value, ok = c.removeValue(context, input) // Will block when empty.
 			if !ok {
-				break // The input queue has been closed.
+				break // The input queue has been closed or the group torn down.
 			}
 
 			// Write to all output queues.
 			iterator.ToStart()
 			for iterator.HasNext() {
 				var output = iterator.GetNext()
-				output.AddValue(value) // Will block when full.
+				if c.addValue(output, value) == Closed {
+					// An output queue has been closed so tear down the fork.
+					c.closeInputs(context, input)
+				}
 			}
 		}
 
@@ -146,8 +176,11 @@ func (c *queueClass_[V]) Split(
 	var outputs = listClass.List()
 	var counter uint
 	for ; counter < size; counter++ {
-		outputs.AppendValue(c.QueueWithCapacity(capacity))
+		var output = c.QueueWithCapacity(capacity)
+		groupClass().connectChannels(group, output)
+		outputs.AppendValue(output)
 	}
+	var context = groupClass().contextOf(group)
 
 	// Connect up the input queue to the output queues.
 	group.Go(func() {
@@ -155,14 +188,17 @@ func (c *queueClass_[V]) Split(
 		var iterator = outputs.GetIterator()
 		for {
 			// Read from the input queue.
-			var value, ok = input.RemoveFirst() // Will block when empty.
+			var value, ok = c.removeValue(context, input) // Will block when empty.
 			if !ok {
-				break // The input queue has been closed.
+				break // The input queue has been closed or the group torn down.
 			}
 
 			// Write to the next output queue.
 			var output = iterator.GetNext()
-			output.AddValue(value) // Will block when full.
+			if c.addValue(output, value) == Closed {
+				// An output queue has been closed so tear down the split.
+				c.closeInputs(context, input)
+			}
 			if !iterator.HasNext() {
 				iterator.ToStart()
 			}
@@ -189,23 +225,196 @@ func (c *queueClass_[V]) Join(
 	}
 
 	// Create the new output queue.
-	var iterator = inputs.GetIterator()
-	var capacity = iterator.GetNext().GetCapacity()
+	var open = inputs.AsArray()
+	var capacity = open[0].GetCapacity()
 	var output = c.QueueWithCapacity(capacity)
+	groupClass().connectChannels(group, output)
+	var context = groupClass().contextOf(group)
 
 	// Connect up the input queues to the output queue.
 	group.Go(func() {
-		// Take turns reading from each input queue and writing to the output queue.
-		iterator.ToStart()
+		// Take turns reading from each input queue that is still open and writing
+		// to the output queue.
+		var index int
+		for len(open) > 0 {
+			var input = open[index]
+			var value, status = input.RemoveFirstWithContext(context) // Will block when empty.
+			switch status {
+			case Succeeded:
+				if c.addValue(output, value) == Closed {
+					// The output queue has been closed so tear down the join.
+					c.closeInputs(context, open...)
+				}
+				index++
+			case Closed:
+				// The input queue has been closed and all of its values removed.
+				open = slc.Delete(open, index, index+1)
+			default:
+				open = nil // The group has been torn down.
+			}
+			if index >= len(open) {
+				index = 0
+			}
+		}
+
+		// Close the output queue.
+		output.CloseChannel()
+	})
+
+	return output
+}
+
+func (c *queueClass_[V]) Map(
+	group Synchronized,
+	input QueueLike[V],
+	mapping MappingFunction[V],
+) QueueLike[V] {
+	// Validate the arguments.
+	if uti.IsUndefined(mapping) {
+		panic("The \"mapping\" attribute is required by this class.")
+	}
+
+	// Create the new output queue.
+	var output = c.QueueWithCapacity(input.GetCapacity())
+	groupClass().connectChannels(group, output)
+	var context = groupClass().contextOf(group)
+
+	// Connect up the input queue to the output queue.
+	group.Go(func() {
+		// Write the mapping of each value read from the input queue to the output
+		// queue.
 		for {
-			var input = iterator.GetNext()
-			var value, ok = input.RemoveFirst() // Will block when empty.
+			var value, ok = c.removeValue(context, input) // Will block when empty.
 			if !ok {
-				break // The input queue has been closed.
+				break // The input queue has been closed or the group torn down.
 			}
-			output.AddValue(value) // Will block when full.
-			if !iterator.HasNext() {
-				iterator.ToStart()
+			if c.addValue(output, mapping(value)) == Closed {
+				// The output queue has been closed so tear down the map.
+				c.closeInputs(context, input)
+			}
+		}
+
+		// Close the output queue.
+		output.CloseChannel()
+	})
+
+	return output
+}
+
+func (c *queueClass_[V]) MapInParallel(
+	group Synchronized,
+	input QueueLike[V],
+	mapping MappingFunction[V],
+	size uint,
+) QueueLike[V] {
+	// Validate the arguments.
+	if uti.IsUndefined(mapping) {
+		panic("The \"mapping\" attribute is required by this class.")
+	}
+	if size < 2 {
+		panic("The number of workers for a parallel map must be greater than one.")
+	}
+
+	// Create the new output queue.
+	var capacity = input.GetCapacity()
+	var output = c.QueueWithCapacity(capacity)
+	groupClass().connectChannels(group, output)
+	var context = groupClass().contextOf(group)
+
+	// Create the channels that connect the go-routines.  At most capacity values
+	// may be in progress at the same time, so each value can be buffered in the
+	// reordering slot selected by its sequence number until it is its turn.
+	var tokens = make(chan struct{}, capacity)
+	var work = make(chan sequenced_[V], capacity)
+	var slots = make([]chan V, capacity)
+	for index := range slots {
+		slots[index] = make(chan V, 1)
+	}
+	var total = make(chan uint, 1)
+
+	// Assign a sequence number to each value read from the input queue.
+	group.Go(func() {
+		defer close(work)
+		var sequence uint
+		for {
+			var value, ok = c.removeValue(context, input) // Will block when empty.
+			if !ok {
+				break // The input queue has been closed or the group torn down.
+			}
+			select {
+			case tokens <- struct{}{}: // Will block when the window is full.
+			case <-context.Done():
+				return // The group has been torn down.
+			}
+			work <- sequenced_[V]{sequence_: sequence, value_: value}
+			sequence++
+		}
+		total <- sequence
+	})
+
+	// Apply the mapping function to the values in parallel.
+	var counter uint
+	for ; counter < size; counter++ {
+		group.Go(func() {
+			for next := range work {
+				slots[next.sequence_%capacity] <- mapping(next.value_)
+			}
+		})
+	}
+
+	// Write the mapped values to the output queue in their original order.
+	group.Go(func() {
+		var sequence uint
+		var last = ^uint(0) // This is unknown until the input queue is closed.
+		for sequence != last {
+			select {
+			case value := <-slots[sequence%capacity]:
+				if c.addValue(output, value) == Closed {
+					// The output queue has been closed so tear down the map.
+					c.closeInputs(context, input)
+				}
+				sequence++
+				<-tokens // Make room in the window for another value.
+			case last = <-total:
+			case <-context.Done():
+				last = sequence // The group has been torn down.
+			}
+		}
+
+		// Close the output queue.
+		output.CloseChannel()
+	})
+
+	return output
+}
+
+func (c *queueClass_[V]) Filter(
+	group Synchronized,
+	input QueueLike[V],
+	predicate PredicateFunction[V],
+) QueueLike[V] {
+	// Validate the arguments.
+	if uti.IsUndefined(predicate) {
+		panic("The \"predicate\" attribute is required by this class.")
+	}
+
+	// Create the new output queue.
+	var output = c.QueueWithCapacity(input.GetCapacity())
+	groupClass().connectChannels(group, output)
+	var context = groupClass().contextOf(group)
+
+	// Connect up the input queue to the output queue.
+	group.Go(func() {
+		// Write each value read from the input queue that satisfies the predicate
+		// to the output queue.
+		for {
+			var value, ok = c.removeValue(context, input) // Will block when empty.
+			if !ok {
+				break // The input queue has been closed or the group torn down.
+			}
+			if predicate(value) && c.addValue(output, value) == Closed {
+				// The output queue has been closed so tear down the filter.
+				c.closeInputs(context, input)
 			}
 		}
 
@@ -224,10 +433,62 @@ func (v *queue_[V]) GetClass() QueueClassLike[V] {
 	return queueClass[V]()
 }
 
+func (v *queue_[V]) AddValueWithContext(
+	context ctx.Context,
+	value V,
+) Status {
+	return v.addValue(value, context.Done(), true, context.Err)
+}
+
+func (v *queue_[V]) AddValueWithTimeout(
+	value V,
+	timeout ele.DurationLike,
+) Status {
+	var context, cancel = v.contextWithTimeout(timeout)
+	defer cancel()
+	return v.AddValueWithContext(context, value)
+}
+
+func (v *queue_[V]) TryAddValue(
+	value V,
+) Status {
+	return v.addValue(value, nil, false, nil)
+}
+
+func (v *queue_[V]) RemoveFirstWithContext(
+	context ctx.Context,
+) (
+	first V,
+	status Status,
+) {
+	return v.removeFirst(context.Done(), true, context.Err)
+}
+
+func (v *queue_[V]) RemoveFirstWithTimeout(
+	timeout ele.DurationLike,
+) (
+	first V,
+	status Status,
+) {
+	var context, cancel = v.contextWithTimeout(timeout)
+	defer cancel()
+	return v.RemoveFirstWithContext(context)
+}
+
+func (v *queue_[V]) TryRemoveFirst() (
+	first V,
+	status Status,
+) {
+	return v.removeFirst(nil, false, nil)
+}
+
 // Attribute Methods
 
 func (v *queue_[V]) GetCapacity() uint {
-	return v.capacity_
+	v.mutex_.Lock()
+	var capacity = v.capacity_
+	v.mutex_.Unlock()
+	return capacity
 }
 
 // Fifo[V] Methods
@@ -235,10 +496,10 @@ func (v *queue_[V]) GetCapacity() uint {
 func (v *queue_[V]) AddValue(
 	value V,
 ) {
-	v.mutex_.Lock()
-	v.values_.AppendValue(value)
-	v.mutex_.Unlock()
-	v.available_ <- true // The queue will block if at capacity.
+	var status = v.addValue(value, nil, true, nil) // Will block when full.
+	if status == Closed {
+		panic("A value cannot be added to a closed queue.")
+	}
 }
 
 func (v *queue_[V]) RemoveFirst() (
@@ -246,27 +507,27 @@ func (v *queue_[V]) RemoveFirst() (
 	ok bool,
 ) {
 	// Remove the first value from the queue if one exists.
-	_, ok = <-v.available_ // Will block until a value is available.
-	if ok {
-		v.mutex_.Lock()
-		first = v.values_.RemoveValue(1)
-		v.mutex_.Unlock()
-	}
+	var status Status
+	first, status = v.removeFirst(nil, true, nil) // Will block when empty.
+	ok = status == Succeeded
 	return
 }
 
 func (v *queue_[V]) RemoveAll() {
 	v.mutex_.Lock()
-	v.available_ = make(chan bool, v.capacity_)
-	var listClass = ListClass[V]()
-	v.values_ = listClass.List()
+	clear(v.values_) // Allow the values to be garbage collected.
+	v.head_ = 0
+	v.size_ = 0
+	v.wake(&v.notFull_)
 	v.mutex_.Unlock()
 }
 
 func (v *queue_[V]) CloseChannel() {
 	v.mutex_.Lock()
-	close(v.available_)
+	v.closed_ = true
 	// No more values can be placed on the queue.
+	v.wake(&v.notEmpty_)
+	v.wake(&v.notFull_)
 	v.mutex_.Unlock()
 }
 
@@ -274,53 +535,290 @@ func (v *queue_[V]) CloseChannel() {
 
 func (v *queue_[V]) IsEmpty() bool {
 	v.mutex_.Lock()
-	var result = len(v.available_) == 0
+	var result = v.size_ == 0
 	v.mutex_.Unlock()
 	return result
 }
 
 func (v *queue_[V]) GetSize() uint {
 	v.mutex_.Lock()
-	var size = uint(len(v.available_))
+	var size = uint(v.size_)
 	v.mutex_.Unlock()
 	return size
 }
 
 func (v *queue_[V]) AsArray() []V {
 	v.mutex_.Lock()
-	var array = v.values_.AsArray()
+	var array = make([]V, v.size_)
+	for index := range array {
+		array[index] = v.values_[(v.head_+index)%len(v.values_)]
+	}
 	v.mutex_.Unlock()
 	return array
 }
 
 func (v *queue_[V]) GetIterator() age.IteratorLike[V] {
-	v.mutex_.Lock()
-	var iterator = v.values_.GetIterator()
-	v.mutex_.Unlock()
+	var iteratorClass = age.IteratorClass[V]()
+	var iterator = iteratorClass.Iterator(v.AsArray())
 	return iterator
 }
 
 // PROTECTED INTERFACE
 
 func (v *queue_[V]) String() string {
-	return uti.Format(v)
+	return fmt.Sprintf("%v", ListClass[V]().ListFromArray(v.AsArray()))
+}
+
+func (v *queue_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsArray())
+}
+
+func (v *queue_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var list = ListClass[V]().List()
+	var err = jsn.Unmarshal(bytes, list)
+	if err != nil {
+		return err
+	}
+	var values = list.AsArray()
+	v.mutex_.Lock()
+	var size = uint(len(values))
+	if size > v.capacity_ {
+		v.capacity_ = size
+	}
+	v.values_ = values
+	v.head_ = 0
+	v.size_ = len(values)
+	v.wake(&v.notEmpty_)
+	v.mutex_.Unlock()
+	return nil
 }
 
 // Private Methods
 
+// This private class method adds the specified value to the specified output
+// queue for one of the class functions.  It returns the status of the addition.
+func (c *queueClass_[V]) addValue(
+	output QueueLike[V],
+	value V,
+) Status {
+	return output.AddValueWithContext(ctx.Background(), value) // Will block when full.
+}
+
+// This private class method closes the specified input queues for one of the
+// class functions once one of its output queues has been closed by a consumer.
+// The input queues are left open if the output queue was closed because the
+// group was torn down, since the producers upstream share the context of the
+// group.
+func (c *queueClass_[V]) closeInputs(
+	context ctx.Context,
+	inputs ...QueueLike[V],
+) {
+	if context.Err() != nil {
+		return
+	}
+	for _, input := range inputs {
+		input.CloseChannel()
+	}
+}
+
+// This private class method removes the next value from the specified input
+// queue for one of the class functions.  It returns false once the input queue
+// has been closed and all of its values removed, or the group has been torn
+// down.
+func (c *queueClass_[V]) removeValue(
+	context ctx.Context,
+	input QueueLike[V],
+) (
+	value V,
+	ok bool,
+) {
+	var status Status
+	value, status = input.RemoveFirstWithContext(context) // Will block when empty.
+	ok = status == Succeeded
+	return
+}
+
+// This private instance method adds the specified value to the end of the
+// queue.  If wait is false the method does not wait for room in the queue,
+// otherwise it waits until there is room, the queue is closed or the done
+// channel is closed.  The reason function, if any, explains why the done
+// channel was closed.
+func (v *queue_[V]) addValue(
+	value V,
+	done <-chan struct{},
+	wait bool,
+	reason func() error,
+) Status {
+	v.mutex_.Lock()
+	for {
+		switch {
+		case v.closed_:
+			v.mutex_.Unlock()
+			return Closed
+		case uint(v.size_) < v.capacity_:
+			v.grow()
+			v.values_[(v.head_+v.size_)%len(v.values_)] = value
+			v.size_++
+			v.wake(&v.notEmpty_)
+			v.mutex_.Unlock()
+			return Succeeded
+		case !wait:
+			v.mutex_.Unlock()
+			return Blocked
+		}
+
+		// Wait for a value to be removed from the queue.
+		var signal = v.signal(&v.notFull_)
+		v.mutex_.Unlock()
+		select {
+		case <-signal:
+		case <-done:
+			return v.statusOf(reason)
+		}
+		v.mutex_.Lock()
+	}
+}
+
+// This private instance method returns a new context that is cancelled after
+// the specified timeout along with its cancel function.
+func (v *queue_[V]) contextWithTimeout(
+	timeout ele.DurationLike,
+) (ctx.Context, ctx.CancelFunc) {
+	var duration = tim.Duration(timeout.AsIntrinsic()) * tim.Millisecond
+	return ctx.WithTimeout(ctx.Background(), duration)
+}
+
+// This private instance method doubles the size of the ring buffer holding the
+// values when it is full, up to the capacity of the queue.  The values are
+// copied so that the first value is at the start of the new ring buffer.
+func (v *queue_[V]) grow() {
+	if v.size_ < len(v.values_) {
+		return
+	}
+	var size = min(max(2*len(v.values_), 8), int(v.capacity_))
+	var values = make([]V, size)
+	for index := range v.size_ {
+		values[index] = v.values_[(v.head_+index)%len(v.values_)]
+	}
+	v.values_ = values
+	v.head_ = 0
+}
+
+// This private instance method removes the first value from the queue.  If
+// wait is false the method does not wait for a value, otherwise it waits until
+// a value is available, the queue is closed and empty or the done channel is
+// closed.  The reason function, if any, explains why the done channel was
+// closed.
+func (v *queue_[V]) removeFirst(
+	done <-chan struct{},
+	wait bool,
+	reason func() error,
+) (
+	first V,
+	status Status,
+) {
+	v.mutex_.Lock()
+	for {
+		switch {
+		case v.size_ > 0:
+			first = v.values_[v.head_]
+			var zero V
+			v.values_[v.head_] = zero // Allow the value to be garbage collected.
+			v.head_ = (v.head_ + 1) % len(v.values_)
+			v.size_--
+			v.wake(&v.notFull_)
+			v.mutex_.Unlock()
+			status = Succeeded
+			return
+		case v.closed_:
+			// The queue has been closed and all of its values removed.
+			v.mutex_.Unlock()
+			status = Closed
+			return
+		case !wait:
+			v.mutex_.Unlock()
+			status = Blocked
+			return
+		}
+
+		// Wait for a value to be added to the queue.
+		var signal = v.signal(&v.notEmpty_)
+		v.mutex_.Unlock()
+		select {
+		case <-signal:
+		case <-done:
+			status = v.statusOf(reason)
+			return
+		}
+		v.mutex_.Lock()
+	}
+}
+
+// This private instance method returns the specified signal channel, creating
+// it if no other go-routine is already waiting on it.  The mutex must be locked
+// when it is called.
+func (v *queue_[V]) signal(
+	signal *chan struct{},
+) <-chan struct{} {
+	if *signal == nil {
+		*signal = make(chan struct{})
+	}
+	return *signal
+}
+
+// This private instance method returns the status corresponding to the reason
+// that an operation stopped waiting.
+func (v *queue_[V]) statusOf(
+	reason func() error,
+) Status {
+	if reason != nil && reason() == ctx.DeadlineExceeded {
+		return TimedOut
+	}
+	return Cancelled
+}
+
+// This private instance method wakes up all go-routines waiting on the
+// specified signal channel, if any, by closing it.  The mutex must be locked
+// when it is called.
+func (v *queue_[V]) wake(
+	signal *chan struct{},
+) {
+	if *signal != nil {
+		close(*signal)
+		*signal = nil
+	}
+}
+
 // Instance Structure
 
 // NOTE:
-// If the Go "chan" type ever supports snapshots of its state, the underlying
-// list and mutex can be removed and the channel modified to pass the values
-// instead of the availability. Currently, the underlying list is only required
-// by the "AsArray()" instance method.
+// The values are maintained in a ring buffer that grows as needed up to the
+// capacity of the queue, so that adding or removing a value takes O[1] time.
+// The signal channels are only created while go-routines are waiting for a
+// value to be added (notEmpty) or removed (notFull) and are closed to wake all
+// of them up.  Unlike a condition variable, a signal channel can be waited on
+// along with a context so that waiting can be abandoned.
 type queue_[V any] struct {
 	// Declare the instance attributes.
-	available_ chan bool
-	capacity_  uint
-	mutex_     syn.Mutex
-	values_    ListLike[V]
+	capacity_ uint
+	closed_   bool
+	head_     int
+	mutex_    syn.Mutex
+	notEmpty_ chan struct{}
+	notFull_  chan struct{}
+	size_     int
+	values_   []V
+}
+
+// NOTE:
+// This private type associates a value with its position in the sequence of
+// values read from an input queue so that the order of the values can be
+// restored after they have been processed in parallel.
+type sequenced_[V any] struct {
+	sequence_ uint
+	value_    V
 }
 
 // Class Structure
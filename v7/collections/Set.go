@@ -13,8 +13,10 @@
 package collections
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
+	ran "github.com/craterdog/go-component-framework/v7/ranges"
 	str "github.com/craterdog/go-component-framework/v7/strings"
 	uti "github.com/craterdog/go-missing-utilities/v7"
 	syn "sync"
@@ -53,6 +55,26 @@ func (c *setClass_[V]) SetWithCollator(
 	return instance
 }
 
+func (c *setClass_[V]) TreeSet() SetLike[V] {
+	var collatorClass = age.CollatorClass[V]()
+	var collator = collatorClass.Collator()
+	var instance = c.TreeSetWithCollator(collator)
+	return instance
+}
+
+func (c *setClass_[V]) TreeSetWithCollator(
+	collator age.CollatorLike[V],
+) SetLike[V] {
+	if uti.IsUndefined(collator) {
+		panic("The \"collator\" attribute is required by this class.")
+	}
+	var instance = &treeSet_[V]{
+		// Initialize the instance attributes.
+		collator_: collator,
+	}
+	return instance
+}
+
 func (c *setClass_[V]) SetFromArray(
 	values []V,
 ) SetLike[V] {
@@ -75,6 +97,26 @@ func (c *setClass_[V]) SetFromSequence(
 	return set
 }
 
+func (c *setClass_[V]) SetFromString(
+	source string,
+) SetLike[V] {
+	var values = collectionParserClass().parseSequence(source, "set")
+	var set = c.Set()
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value, ok = iterator.GetNext().(V)
+		if !ok {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the set constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		set.AddValue(value)
+	}
+	return set
+}
+
 // Constant Methods
 
 // Function Methods
@@ -83,8 +125,7 @@ func (c *setClass_[V]) And(
 	first SetLike[V],
 	second SetLike[V],
 ) SetLike[V] {
-	var collator = first.GetCollator()
-	var result = c.SetWithCollator(collator)
+	var result = c.emptySet(first)
 	var iterator = first.GetIterator()
 	for iterator.HasNext() {
 		var value = iterator.GetNext()
@@ -99,8 +140,7 @@ func (c *setClass_[V]) Ior(
 	first SetLike[V],
 	second SetLike[V],
 ) SetLike[V] {
-	var collator = first.GetCollator()
-	var result = c.SetWithCollator(collator)
+	var result = c.emptySet(first)
 	result.AddValues(first)
 	result.AddValues(second)
 	return result
@@ -110,8 +150,7 @@ func (c *setClass_[V]) San(
 	first SetLike[V],
 	second SetLike[V],
 ) SetLike[V] {
-	var collator = first.GetCollator()
-	var result = c.SetWithCollator(collator)
+	var result = c.emptySet(first)
 	result.AddValues(first)
 	result.RemoveValues(second)
 	return result
@@ -211,6 +250,24 @@ func (v *set_[V]) RemoveAll() {
 	v.values_.RemoveAll()
 }
 
+// Ranged[V] Methods
+
+func (v *set_[V]) GetValuesWithin(
+	bounds ran.Bounded[V],
+) str.Sequential[V] {
+	var first, last = setClass[V]().slotsWithin(
+		bounds,
+		v.findSlot,
+		v.GetSize(),
+	)
+	var listClass = ListClass[V]()
+	if first == last {
+		return listClass.List()
+	}
+	var values = v.values_.GetValues(int(first)+1, int(last))
+	return values
+}
+
 // str.Searchable[V] Methods
 
 func (v *set_[V]) ContainsValue(
@@ -274,11 +331,94 @@ func (v *set_[V]) GetIterator() age.IteratorLike[V] {
 // PROTECTED INTERFACE
 
 func (v *set_[V]) String() string {
-	return uti.Format(v)
+	return fmt.Sprintf("%v", v.values_)
+}
+
+func (v *set_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.values_)
+}
+
+func (v *set_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var values = ListClass[V]().List()
+	var err = jsn.Unmarshal(bytes, values)
+	if err != nil {
+		return err
+	}
+	v.RemoveAll()
+	v.AddValues(values)
+	return nil
 }
 
 // Private Methods
 
+// This private class method returns the half-open range of zero-based slots
+// [first, last) containing the values of a sorted collection that lie within
+// the specified bounds.  The specified find function must return the zero-based
+// slot of a value in the collection, or if not found, the slot in which it could
+// be inserted.
+func (c *setClass_[V]) slotsWithin(
+	bounds ran.Bounded[V],
+	find func(value V) (slot uint, found bool),
+	size uint,
+) (
+	first uint,
+	last uint,
+) {
+	last = size
+	var minimum = bounds.GetMinimum()
+	if c.isEndpoint(minimum) {
+		var slot, found = find(minimum)
+		first = slot
+		if found && bounds.GetLeft() == ran.Exclusive {
+			// The minimum value itself is excluded.
+			first++
+		}
+	}
+	var maximum = bounds.GetMaximum()
+	if c.isEndpoint(maximum) {
+		var slot, found = find(maximum)
+		last = slot
+		if found && bounds.GetRight() == ran.Inclusive {
+			// The maximum value itself is included.
+			last++
+		}
+	}
+	if last < first {
+		// The bounds do not contain any values.
+		last = first
+	}
+	return first, last
+}
+
+// This private class method determines whether or not the specified value is
+// an actual endpoint of some bounds.  Elements like numbers define their own
+// notion of an undefined value which leaves that end of the bounds open.
+func (c *setClass_[V]) isEndpoint(
+	value V,
+) bool {
+	switch actual := any(value).(type) {
+	case interface{ IsDefined() bool }:
+		return actual.IsDefined()
+	default:
+		return uti.IsDefined(value)
+	}
+}
+
+// This private class method returns a new empty set that uses the same collator
+// and implementation as the specified set.
+func (c *setClass_[V]) emptySet(
+	set SetLike[V],
+) SetLike[V] {
+	var collator = set.GetCollator()
+	var _, isTree = set.(*treeSet_[V])
+	if isTree {
+		return c.TreeSetWithCollator(collator)
+	}
+	return c.SetWithCollator(collator)
+}
+
 // This private instance method performs a binary search of the set for the
 // specified value. It returns two results:
 //   - index: The index of the value, or if not found, the slot in which it could
@@ -320,6 +460,18 @@ func (v *set_[V]) findIndex(value V) (index int, found bool) {
 	return last, false
 }
 
+// This private instance method returns the zero-based slot of the specified
+// value in the set, or if not found, the slot in which it could be inserted.
+func (v *set_[V]) findSlot(value V) (slot uint, found bool) {
+	var index int
+	index, found = v.findIndex(value)
+	if found {
+		// Convert the ordinal index into a zero-based slot.
+		index--
+	}
+	return uint(index), found
+}
+
 // Instance Structure
 
 type set_[V any] struct {
@@ -13,10 +13,10 @@
 package collections
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	str "github.com/craterdog/go-component-framework/v7/strings"
-	uti "github.com/craterdog/go-missing-utilities/v7"
 	syn "sync"
 )
 
@@ -83,6 +83,27 @@ func (c *stackClass_[V]) StackFromSequence(
 	return instance
 }
 
+func (c *stackClass_[V]) StackFromString(
+	source string,
+) StackLike[V] {
+	// The top of the stack is the first value in the literal.
+	var values = collectionParserClass().parseSequence(source, "stack")
+	var array = make([]V, 0, values.GetSize())
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value, ok = iterator.GetNext().(V)
+		if !ok {
+			var message = fmt.Sprintf(
+				"An illegal string was passed to the stack constructor method: %s",
+				source,
+			)
+			panic(message)
+		}
+		array = append(array, value)
+	}
+	return c.StackFromArray(array)
+}
+
 // Constant Methods
 
 // Function Methods
@@ -156,7 +177,27 @@ func (v *stack_[V]) GetIterator() age.IteratorLike[V] {
 // PROTECTED INTERFACE
 
 func (v *stack_[V]) String() string {
-	return uti.Format(v)
+	return fmt.Sprintf("%v", v.values_)
+}
+
+func (v *stack_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.values_)
+}
+
+func (v *stack_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	// The top of the stack is the first value in the JSON array.
+	var values = ListClass[V]().List()
+	var err = jsn.Unmarshal(bytes, values)
+	if err != nil {
+		return err
+	}
+	if values.GetSize() > v.capacity_ {
+		v.capacity_ = values.GetSize()
+	}
+	v.values_ = values
+	return nil
 }
 
 // Private Methods
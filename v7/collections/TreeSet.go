@@ -0,0 +1,246 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package collections
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	ran "github.com/craterdog/go-component-framework/v7/ranges"
+	str "github.com/craterdog/go-component-framework/v7/strings"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+)
+
+// NOTE:
+// A tree set is an alternative implementation of the set class whose values are
+// maintained in the same balanced tree that is used by the persistent
+// collections rather than in a list.  Its instances are created using the
+// TreeSet() and TreeSetWithCollator() constructors of the set class.
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *treeSet_[V]) GetClass() SetClassLike[V] {
+	return setClass[V]()
+}
+
+// Attribute Methods
+
+func (v *treeSet_[V]) GetCollator() age.CollatorLike[V] {
+	return v.collator_
+}
+
+// str.Accessible[V] Methods
+
+func (v *treeSet_[V]) GetValue(
+	index int,
+) V {
+	var slot = uti.RelativeToCardinal(index, v.GetSize())
+	return v.root_.getValue(uint(slot))
+}
+
+func (v *treeSet_[V]) GetValues(
+	first int,
+	last int,
+) str.Sequential[V] {
+	var size = v.GetSize()
+	var goFirst = uti.RelativeToCardinal(first, size)
+	var goLast = uti.RelativeToCardinal(last, size)
+	var listClass = ListClass[V]()
+	var values = listClass.List()
+	for slot := goFirst; slot <= goLast; slot++ {
+		values.AppendValue(v.root_.getValue(uint(slot)))
+	}
+	return values
+}
+
+func (v *treeSet_[V]) GetIndex(
+	value V,
+) int {
+	var slot, found = v.root_.findSlot(v.ranker(value))
+	if !found {
+		return 0
+	}
+	return int(slot) + 1
+}
+
+// Elastic[V] Methods
+
+func (v *treeSet_[V]) AddValue(
+	value V,
+) {
+	var slot, found = v.root_.findSlot(v.ranker(value))
+	if !found {
+		// The value is not already a member, so add it.
+		v.root_ = v.root_.insertValue(slot, value)
+	}
+}
+
+func (v *treeSet_[V]) AddValues(
+	values str.Sequential[V],
+) {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		v.AddValue(value)
+	}
+}
+
+func (v *treeSet_[V]) RemoveValue(
+	value V,
+) {
+	var slot, found = v.root_.findSlot(v.ranker(value))
+	if found {
+		// The value is a member, so remove it.
+		v.root_ = v.root_.removeValue(slot)
+	}
+}
+
+func (v *treeSet_[V]) RemoveValues(
+	values str.Sequential[V],
+) {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		v.RemoveValue(value)
+	}
+}
+
+func (v *treeSet_[V]) RemoveAll() {
+	v.root_ = nil
+}
+
+// Ranged[V] Methods
+
+func (v *treeSet_[V]) GetValuesWithin(
+	bounds ran.Bounded[V],
+) str.Sequential[V] {
+	var first, last = setClass[V]().slotsWithin(
+		bounds,
+		v.findSlot,
+		v.GetSize(),
+	)
+	var listClass = ListClass[V]()
+	var values = listClass.ListFromArray(v.root_.appendRange(first, last, nil))
+	return values
+}
+
+// str.Searchable[V] Methods
+
+func (v *treeSet_[V]) ContainsValue(
+	value V,
+) bool {
+	var _, found = v.root_.findSlot(v.ranker(value))
+	return found
+}
+
+func (v *treeSet_[V]) ContainsAny(
+	values str.Sequential[V],
+) bool {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		if v.ContainsValue(value) {
+			// This set contains at least one of the values.
+			return true
+		}
+	}
+	// This set does not contain any of the values.
+	return false
+}
+
+func (v *treeSet_[V]) ContainsAll(
+	values str.Sequential[V],
+) bool {
+	var iterator = values.GetIterator()
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		if !v.ContainsValue(value) {
+			// This set is missing at least one of the values.
+			return false
+		}
+	}
+	// This set does contains all of the values.
+	return true
+}
+
+// str.Sequential[V] Methods
+
+func (v *treeSet_[V]) IsEmpty() bool {
+	return v.root_ == nil
+}
+
+func (v *treeSet_[V]) GetSize() uint {
+	return v.root_.getSize()
+}
+
+func (v *treeSet_[V]) AsArray() []V {
+	var array = make([]V, 0, v.GetSize())
+	return v.root_.appendValues(array)
+}
+
+func (v *treeSet_[V]) GetIterator() age.IteratorLike[V] {
+	var iteratorClass = age.IteratorClass[V]()
+	var iterator = iteratorClass.Iterator(v.AsArray())
+	return iterator
+}
+
+// PROTECTED INTERFACE
+
+func (v *treeSet_[V]) String() string {
+	return fmt.Sprintf("%v", ListClass[V]().ListFromArray(v.AsArray()))
+}
+
+func (v *treeSet_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsArray())
+}
+
+func (v *treeSet_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var values = ListClass[V]().List()
+	var err = jsn.Unmarshal(bytes, values)
+	if err != nil {
+		return err
+	}
+	v.RemoveAll()
+	v.AddValues(values)
+	return nil
+}
+
+// Private Methods
+
+// This private instance method returns a function that ranks the specified
+// value against a candidate value in the set.
+func (v *treeSet_[V]) ranker(
+	value V,
+) func(candidate V) age.Rank {
+	return func(candidate V) age.Rank {
+		return v.collator_.RankValues(value, candidate)
+	}
+}
+
+// This private instance method returns the zero-based slot of the specified
+// value in the set, or if not found, the slot in which it could be inserted.
+func (v *treeSet_[V]) findSlot(value V) (slot uint, found bool) {
+	return v.root_.findSlot(v.ranker(value))
+}
+
+// Instance Structure
+
+type treeSet_[V any] struct {
+	// Declare the instance attributes.
+	collator_ age.CollatorLike[V]
+	root_     *node_[V]
+}
@@ -13,8 +13,19 @@
 /*
 Package "collections" declares a set of collection classes that maintain values
 of a generic type:
+  - Bag (an ordered multiset that counts duplicate values)
   - Catalog (a sortable map of key-value associations)
+  - Deque (a double-ended queue)
+  - Dictionary (a map of key-value associations ordered by key)
+  - Graph (a directed graph of nodes and edges)
+  - Group (a synchronized group of go-routines that propagates errors)
+  - Hierarchy (a tree of values addressed by name paths)
   - List (a sortable list)
+  - MultiCatalog (a sortable map of keys to lists of values)
+  - PersistentCatalog (an immutable catalog)
+  - PersistentList (an immutable list)
+  - PersistentSet (an immutable ordered set)
+  - PriorityQueue (a queue ordered by priority)
   - Queue (a blocking FIFO)
   - Set (an ordered set)
   - Stack (a LIFO)
@@ -34,25 +45,58 @@ on interfaces, not on each other.
 package collections
 
 import (
+	ctx "context"
 	age "github.com/craterdog/go-component-framework/v7/agents"
+	ele "github.com/craterdog/go-component-framework/v7/elements"
+	ran "github.com/craterdog/go-component-framework/v7/ranges"
 	str "github.com/craterdog/go-component-framework/v7/strings"
 )
 
 // TYPE DECLARATIONS
 
+/*
+Status is a constrained type representing the possible outcomes of a queue
+operation that does not wait indefinitely.
+*/
+type Status uint8
+
+const (
+	Succeeded Status = iota
+	Blocked
+	TimedOut
+	Cancelled
+	Closed
+)
+
 // FUNCTIONAL DECLARATIONS
 
+/*
+MappingFunction[V any] is a functional type that declares the signature for any
+function that maps a value to another value of the same type.
+*/
+type MappingFunction[V any] func(
+	value V,
+) V
+
+/*
+PredicateFunction[V any] is a functional type that declares the signature for
+any function that determines whether or not a value satisfies a condition.
+*/
+type PredicateFunction[V any] func(
+	value V,
+) bool
+
 // CLASS DECLARATIONS
 
 /*
-AssociationClassLike[K comparable, V any] is a class interface that declares
-the complete set of class constructors, constants and functions that must be
+AssociationClassLike[K any, V any] is a class interface that declares the
+complete set of class constructors, constants and functions that must be
 supported by each concrete association-like class.
 
 An association-like class captures the relationship between a generic typed
 key-value pair.
 */
-type AssociationClassLike[K comparable, V any] interface {
+type AssociationClassLike[K any, V any] interface {
 	// Constructor Methods
 	Association(
 		key K,
@@ -60,6 +104,108 @@ type AssociationClassLike[K comparable, V any] interface {
 	) AssociationLike[K, V]
 }
 
+/*
+BagClassLike[V any] is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete bag-like class.
+
+A bag-like class maintains an ordered multiset of generic typed values—which
+can grow or shrink as needed.  Unlike a set, a bag may contain multiple copies
+of the same value, and it keeps count of the number of copies of each distinct
+value.  The order of the values is determined by a configurable collator agent
+and the distinct values are maintained in a balanced tree, so adding or removing
+a value takes O[log(n)] time.
+
+The following class functions are supported:
+
+And() returns a new bag containing the values that are in both of the specified
+bags, each with the lesser of its two counts.
+
+Ior() returns a new bag containing the values that are in either of the
+specified bags, each with the greater of its two counts.
+
+San() returns a new bag containing the values that are in the first specified
+bag, each with its count reduced by its count in the second specified bag.
+
+Sum() returns a new bag containing the values that are in either of the
+specified bags, each with the sum of its two counts.
+*/
+type BagClassLike[V any] interface {
+	// Constructor Methods
+	Bag() BagLike[V]
+	BagWithCollator(
+		collator age.CollatorLike[V],
+	) BagLike[V]
+	BagFromArray(
+		values []V,
+	) BagLike[V]
+	BagFromSequence(
+		values str.Sequential[V],
+	) BagLike[V]
+	BagFromString(
+		source string,
+	) BagLike[V]
+
+	// Function Methods
+	And(
+		first BagLike[V],
+		second BagLike[V],
+	) BagLike[V]
+	Ior(
+		first BagLike[V],
+		second BagLike[V],
+	) BagLike[V]
+	San(
+		first BagLike[V],
+		second BagLike[V],
+	) BagLike[V]
+	Sum(
+		first BagLike[V],
+		second BagLike[V],
+	) BagLike[V]
+}
+
+/*
+BatcherClassLike[V any] is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete batcher-like class.
+
+A batcher-like class connects a queue of generic typed values to a queue of
+lists of those values.  The following class functions are supported:
+
+Batch() connects the output of the specified input queue with a new output queue
+and returns the new output queue.  The values added to the input queue are
+gathered into lists of the specified size which are added automatically to the
+output queue.  Any remaining values are added as a shorter list when the input
+queue is closed.
+
+Window() connects the output of the specified input queue with a new output
+queue and returns the new output queue.  Each window begins when a value is
+added to the input queue and lasts for the specified duration.  The values that
+were added to the input queue during the window are added as a list to the
+output queue when the window times out.  This pattern is useful when values
+arrive in bursts and are best processed together.
+
+Like the queue class functions, each of these functions runs in a go-routine
+belonging to the specified group, closes its output queue once its input queue
+has been closed and all of its values removed, and only closes its input queue
+when its output queue is closed by a consumer.  If the group is torn down
+instead, the function stops without closing its input queue.
+*/
+type BatcherClassLike[V any] interface {
+	// Function Methods
+	Batch(
+		group Synchronized,
+		input QueueLike[V],
+		size uint,
+	) QueueLike[ListLike[V]]
+	Window(
+		group Synchronized,
+		input QueueLike[V],
+		duration ele.DurationLike,
+	) QueueLike[ListLike[V]]
+}
+
 /*
 CatalogClassLike[K comparable, V any] is a class interface that declares the
 complete set of class constructors, constants and functions that must be
@@ -94,6 +240,9 @@ type CatalogClassLike[K comparable, V any] interface {
 	CatalogFromSequence(
 		associations str.Sequential[AssociationLike[K, V]],
 	) CatalogLike[K, V]
+	CatalogFromString(
+		source string,
+	) CatalogLike[K, V]
 
 	// Function Methods
 	Extract(
@@ -106,6 +255,209 @@ type CatalogClassLike[K comparable, V any] interface {
 	) CatalogLike[K, V]
 }
 
+/*
+CodecClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+codec-like class.
+
+A codec-like class converts element, string and collection values to and from a
+compact canonical binary form.  Each value is translated into a tree of tagged
+nodes whose fields are intrinsic Go values, and the Encode() and Decode()
+methods of the agents encoder convert that tree to and from the bytes, so that
+equal values always result in identical bytes that may be hashed, signed and
+stored.  Each value is encoded as a one byte type tag followed by its fields:
+  - angles, percentages, probabilities and numbers are floats (a number has a
+    real and an imaginary part and a percentage is expressed in percent)
+  - booleans are a bool
+  - durations, glyphs, moments and spans are integers, and instants are a pair
+    of them (seconds and nanoseconds)
+  - binaries are a string containing their bytes
+  - the remaining elements and strings are their literals
+  - bags, lists, queues, sets and stacks are a sequence of values, with each
+    copy of a value in a bag encoded separately
+  - catalogs are a sequence of alternating keys and values in insertion order,
+    and associations are a key and a value
+  - graphs are encoded as the catalog returned by their AsCatalog() method
+  - the Go intrinsic bool, float64, int and string types are encoded as they are
+
+The capacities of queues and stacks are not encoded.  Collections are decoded
+as collections of type any (e.g. ListLike[any] or CatalogLike[any, any]).
+*/
+type CodecClassLike interface {
+	// Constructor Methods
+	Codec() CodecLike
+}
+
+/*
+CollectionParserClassLike is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete collection-parser-like class.
+
+A collection-parser-like class recognizes the collection literal at the start of
+a source string.  Collection literals use the following bracketed notation:
+
+	[]                        (an empty list, queue, set or stack)
+	[value, value, ...]       (a list, queue, set or stack)
+	[:]                       (an empty catalog)
+	[key: value, key: value]  (a catalog)
+
+Each key and value is either an element literal (e.g. ~π, <2024-03-01> or 50%),
+a string literal (e.g. "abc", #ABC or v1.2.3), or a nested collection literal.
+Whitespace, including newlines, may appear between any of the tokens.  This is
+the same notation that is produced by the String() method of each collection
+class, so collections whose values are elements, strings or other such
+collections round-trip through their FromString() constructors.  Nested
+collections are parsed as ListLike[any] and CatalogLike[any, any] values.
+*/
+type CollectionParserClassLike interface {
+	// Constructor Methods
+	CollectionParser() CollectionParserLike
+}
+
+/*
+DequeClassLike[V any] is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete deque-like class.
+
+A deque-like class maintains a double-ended queue of generic typed values that
+may be added to or removed from either end in O[1] time.  A deque created by the
+BlockingDeque() constructor is synchronized and uses the same capacity semantics
+as a queue.  A request to add a value to a blocking deque will block when the
+deque has reached its capacity, and a request to remove a value will block when
+it is empty.  Once a blocking deque has been closed its remaining values may
+still be removed, but a request to add a value will panic.  Removing all of its
+values does not reopen it.  The default capacity for a blocking deque is 16
+values.  Any other deque does not block and has no capacity limit.
+*/
+type DequeClassLike[V any] interface {
+	// Constructor Methods
+	Deque() DequeLike[V]
+	BlockingDeque(
+		capacity uint,
+	) DequeLike[V]
+	DequeFromArray(
+		values []V,
+	) DequeLike[V]
+	DequeFromSequence(
+		values str.Sequential[V],
+	) DequeLike[V]
+	DequeFromString(
+		source string,
+	) DequeLike[V]
+}
+
+/*
+DictionaryClassLike[K any, V any] is a class interface that declares the
+complete set of class constructors, constants and functions that must be
+supported by each concrete dictionary-like class.
+
+A dictionary-like class maintains a set of generic typed key-value associations
+that are always ordered by their keys.  The order of the keys is determined by a
+configurable collator agent, and two keys are the same key if the collator
+ranks them as equal.  The keys are never hashed or compared using the Go "=="
+operator, so any key type that the collator can rank may be used (e.g. NameLike
+or VersionLike), even one that is not comparable (e.g. a slice).  For the same
+reason a dictionary cannot be converted to or from a Go map.  The associations
+are maintained in a balanced tree so finding, adding or removing an association
+takes O[log(n)] time.
+
+The following class functions are supported:
+
+Merge() returns a new dictionary containing all of the associations that are in
+the specified dictionaries.  If a key is present in both dictionaries, the value
+of the key from the second dictionary takes precedence.
+*/
+type DictionaryClassLike[K any, V any] interface {
+	// Constructor Methods
+	Dictionary() DictionaryLike[K, V]
+	DictionaryWithCollator(
+		collator age.CollatorLike[K],
+	) DictionaryLike[K, V]
+	DictionaryFromArray(
+		associations []AssociationLike[K, V],
+	) DictionaryLike[K, V]
+	DictionaryFromSequence(
+		associations str.Sequential[AssociationLike[K, V]],
+	) DictionaryLike[K, V]
+	DictionaryFromString(
+		source string,
+	) DictionaryLike[K, V]
+
+	// Function Methods
+	Merge(
+		first DictionaryLike[K, V],
+		second DictionaryLike[K, V],
+	) DictionaryLike[K, V]
+}
+
+/*
+GraphClassLike[V comparable] is a class interface that declares the complete
+set of class constructors, constants and functions that must be supported by
+each concrete graph-like class.
+
+A graph-like class maintains a directed graph whose nodes are generic typed
+values.  Each edge in a graph leads from a source node to a target node, and
+there is at most one edge between any two nodes in each direction.  The nodes
+are maintained in the order in which they were added to the graph, and the
+successors of each node are maintained in a set.  This means that all traversals
+of a graph are deterministic.
+
+The GraphFromCatalog() constructor creates a graph from a catalog that maps each
+node to a list of the target nodes of its edges.
+*/
+type GraphClassLike[V comparable] interface {
+	// Constructor Methods
+	Graph() GraphLike[V]
+	GraphFromCatalog(
+		adjacencies CatalogLike[V, ListLike[V]],
+	) GraphLike[V]
+}
+
+/*
+GroupClassLike is a class interface that declares the complete set of class
+constructors, constants and functions that must be supported by each concrete
+group-like class.
+
+A group-like class runs a group of go-routines—for example the stages of a queue
+pipeline—and tears down the whole group as soon as any one of them fails.  The
+GroupWithContext() constructor creates a group that is also torn down when the
+specified parent context is cancelled.
+*/
+type GroupClassLike interface {
+	// Constructor Methods
+	Group() GroupLike
+	GroupWithContext(
+		context ctx.Context,
+	) GroupLike
+}
+
+/*
+HierarchyClassLike[V any] is a class interface that declares the complete set of
+class constructors, constants and functions that must be supported by each
+concrete hierarchy-like class.
+
+A hierarchy-like class maintains a tree of generic typed values, each of which
+is addressed by a name path (e.g. /database/primary/host).  The children of each
+node in a hierarchy are maintained in the order in which they were added.  The
+intermediate nodes on a path need not have values of their own.
+
+The HierarchyFromString() constructor accepts a catalog literal whose keys are
+name paths (e.g. [/database/host: "localhost", /database/port: 5432]).
+*/
+type HierarchyClassLike[V any] interface {
+	// Constructor Methods
+	Hierarchy() HierarchyLike[V]
+	HierarchyFromArray(
+		associations []AssociationLike[str.NameLike, V],
+	) HierarchyLike[V]
+	HierarchyFromSequence(
+		associations str.Sequential[AssociationLike[str.NameLike, V]],
+	) HierarchyLike[V]
+	HierarchyFromString(
+		source string,
+	) HierarchyLike[V]
+}
+
 /*
 ListClassLike[V any] is a class interface that declares the complete set of
 class constructors, constants and functions that must be supported by each
@@ -131,6 +483,9 @@ type ListClassLike[V any] interface {
 	ListFromSequence(
 		values str.Sequential[V],
 	) ListLike[V]
+	ListFromString(
+		source string,
+	) ListLike[V]
 
 	// Function Methods
 	Concatenate(
@@ -139,6 +494,244 @@ type ListClassLike[V any] interface {
 	) ListLike[V]
 }
 
+/*
+MultiCatalogClassLike[K comparable, V any] is a class interface that declares
+the complete set of class constructors, constants and functions that must be
+supported by each concrete multi-catalog-like class.
+
+A multi-catalog-like class maintains a sortable set of associations between
+generic typed keys and lists of generic typed values.  Each key-value mapping
+that is added to a multi-catalog appends the value to the list of values for
+its key, so a key may be mapped to any number of values, including duplicates.
+The order of the associations is the order in which their keys were first added
+to the multi-catalog.  The constructors that take an array or sequence of
+associations treat each association as a single key-value mapping.
+
+The following class functions are also supported:
+
+Merge() returns a new multi-catalog containing all of the key-value mappings
+that are in the specified multi-catalogs.  If a key is present in both
+multi-catalogs, the values of the key from the second multi-catalog are
+appended to those from the first.
+*/
+type MultiCatalogClassLike[K comparable, V any] interface {
+	// Constructor Methods
+	MultiCatalog() MultiCatalogLike[K, V]
+	MultiCatalogFromArray(
+		mappings []AssociationLike[K, V],
+	) MultiCatalogLike[K, V]
+	MultiCatalogFromSequence(
+		mappings str.Sequential[AssociationLike[K, V]],
+	) MultiCatalogLike[K, V]
+	MultiCatalogFromString(
+		source string,
+	) MultiCatalogLike[K, V]
+
+	// Function Methods
+	Merge(
+		first MultiCatalogLike[K, V],
+		second MultiCatalogLike[K, V],
+	) MultiCatalogLike[K, V]
+}
+
+/*
+PersistentCatalogClassLike[K comparable, V any] is a class interface that
+declares the complete set of class constructors, constants and functions that
+must be supported by each concrete persistent-catalog-like class.
+
+A persistent-catalog-like class maintains an immutable set of generic typed
+key-value associations in the order in which they were added.  Each method that
+would change a catalog instead returns a new persistent catalog that shares its
+unchanged structure with the original catalog, which is left intact.  The keys
+are found using a hash array mapped trie so finding, adding, changing or
+removing an association takes O[log(n)] time and space.  Since a persistent
+catalog never changes, it may be shared by multiple go-routines without any
+synchronization and each of its versions may be kept as a cheap snapshot.
+
+The following class functions are also supported:
+
+Extract() returns a new persistent catalog containing only the associations that
+are in the specified catalog that have the specified keys.  The associations in
+the resulting catalog will be in the same order as the specified keys.
+
+Merge() returns a new persistent catalog containing all of the associations that
+are in the specified catalogs in the order that they appear in each catalog.  If
+a key is present in both catalogs, the value of the key from the second catalog
+takes precedence.
+*/
+type PersistentCatalogClassLike[K comparable, V any] interface {
+	// Constructor Methods
+	PersistentCatalog() PersistentCatalogLike[K, V]
+	PersistentCatalogFromArray(
+		associations []AssociationLike[K, V],
+	) PersistentCatalogLike[K, V]
+	PersistentCatalogFromMap(
+		associations map[K]V,
+	) PersistentCatalogLike[K, V]
+	PersistentCatalogFromSequence(
+		associations str.Sequential[AssociationLike[K, V]],
+	) PersistentCatalogLike[K, V]
+	PersistentCatalogFromString(
+		source string,
+	) PersistentCatalogLike[K, V]
+
+	// Function Methods
+	Extract(
+		catalog PersistentCatalogLike[K, V],
+		keys str.Sequential[K],
+	) PersistentCatalogLike[K, V]
+	Merge(
+		first PersistentCatalogLike[K, V],
+		second PersistentCatalogLike[K, V],
+	) PersistentCatalogLike[K, V]
+}
+
+/*
+PersistentListClassLike[V any] is a class interface that declares the complete
+set of class constructors, constants and functions that must be supported by
+each concrete persistent-list-like class.
+
+A persistent-list-like class maintains an immutable sequence of values.  Each
+method that would change a list instead returns a new persistent list that
+shares its unchanged structure with the original list, which is left intact.
+The values are maintained in a balanced tree so accessing, inserting, changing
+or removing a value takes O[log(n)] time and space.  Since a persistent list
+never changes, it may be shared by multiple go-routines without any
+synchronization and each of its versions may be kept as a cheap snapshot.  Like
+a list, it uses ORDINAL based indexing.
+
+The following class functions are supported:
+
+Concatenate() combines two persistent lists into a new persistent list
+containing all values in both lists.  The order of the values in each list is
+preserved in the new list.
+*/
+type PersistentListClassLike[V any] interface {
+	// Constructor Methods
+	PersistentList() PersistentListLike[V]
+	PersistentListFromArray(
+		values []V,
+	) PersistentListLike[V]
+	PersistentListFromSequence(
+		values str.Sequential[V],
+	) PersistentListLike[V]
+	PersistentListFromString(
+		source string,
+	) PersistentListLike[V]
+
+	// Function Methods
+	Concatenate(
+		first PersistentListLike[V],
+		second PersistentListLike[V],
+	) PersistentListLike[V]
+}
+
+/*
+PersistentSetClassLike[V any] is a class interface that declares the complete
+set of class constructors, constants and functions that must be supported by
+each concrete persistent-set-like class.
+
+A persistent-set-like class maintains an immutable ordered set of values whose
+order is determined by a configurable collator agent.  Each method that would
+change a set instead returns a new persistent set that shares its unchanged
+structure with the original set, which is left intact.  The values are
+maintained in a balanced tree so finding, adding or removing a value takes
+O[log(n)] time and space.  Since a persistent set never changes, it may be
+shared by multiple go-routines without any synchronization and each of its
+versions may be kept as a cheap snapshot.
+
+The following class functions are supported:
+
+And() returns a new persistent set containing the values that are both of the
+specified sets.
+
+Ior() returns a new persistent set containing the values that are in either of
+the specified sets.
+
+San() returns a new persistent set containing the values that are in the first
+specified set but not in the second specified set.
+
+Xor() returns a new persistent set containing the values that are in the first
+specified set or the second specified set but not both.
+*/
+type PersistentSetClassLike[V any] interface {
+	// Constructor Methods
+	PersistentSet() PersistentSetLike[V]
+	PersistentSetWithCollator(
+		collator age.CollatorLike[V],
+	) PersistentSetLike[V]
+	PersistentSetFromArray(
+		values []V,
+	) PersistentSetLike[V]
+	PersistentSetFromSequence(
+		values str.Sequential[V],
+	) PersistentSetLike[V]
+	PersistentSetFromString(
+		source string,
+	) PersistentSetLike[V]
+
+	// Function Methods
+	And(
+		first PersistentSetLike[V],
+		second PersistentSetLike[V],
+	) PersistentSetLike[V]
+	Ior(
+		first PersistentSetLike[V],
+		second PersistentSetLike[V],
+	) PersistentSetLike[V]
+	San(
+		first PersistentSetLike[V],
+		second PersistentSetLike[V],
+	) PersistentSetLike[V]
+	Xor(
+		first PersistentSetLike[V],
+		second PersistentSetLike[V],
+	) PersistentSetLike[V]
+}
+
+/*
+PriorityQueueClassLike[V any] is a class interface that declares the complete
+set of class constructors, constants and functions that must be supported by
+each concrete priority-queue-like class.
+
+A priority-queue-like class maintains generic typed values in the order
+determined by a ranking function, so the first value removed from a priority
+queue is always the value with the least rank.  Values with equal ranks are
+removed in the order in which they were added.  Adding or removing a value
+takes O[log(n)] time.  The default ranking function is the RankValues() method
+of a collator.
+
+A priority queue created by the BlockingPriorityQueue() constructor is
+synchronized and uses the same capacity semantics as a queue.  A request to add
+a value to a blocking priority queue will block when the priority queue has
+reached its capacity, and a request to remove a value will block when it is
+empty.  Once a blocking priority queue has been closed its remaining values may
+still be removed, but a request to add a value will panic.  Removing all of its
+values does not reopen it.  The default capacity for a blocking priority queue
+is 16 values.  Any other priority queue does not block and has no capacity
+limit.
+*/
+type PriorityQueueClassLike[V any] interface {
+	// Constructor Methods
+	PriorityQueue() PriorityQueueLike[V]
+	PriorityQueueWithRanker(
+		ranker age.RankingFunction[V],
+	) PriorityQueueLike[V]
+	BlockingPriorityQueue(
+		ranker age.RankingFunction[V],
+		capacity uint,
+	) PriorityQueueLike[V]
+	PriorityQueueFromArray(
+		values []V,
+	) PriorityQueueLike[V]
+	PriorityQueueFromSequence(
+		values str.Sequential[V],
+	) PriorityQueueLike[V]
+	PriorityQueueFromString(
+		source string,
+	) PriorityQueueLike[V]
+}
+
 /*
 QueueClassLike[V any] is a class interface that declares the complete set of
 class constructors, constants and functions that must be supported by each
@@ -149,7 +742,8 @@ and therefore enforces synchronized, first-in-first-out (FIFO) symantics for
 generic typed values.  An optional queue capacity may be specified.  A request
 to add a value to a queue will block when the queue has reached its maximum
 capacity.  It will also block on attempts to remove a value when it is empty.
-The default capacity for a queue-like class is 16 values.
+The default capacity for a queue-like class is 16 values.  The values in a queue
+are maintained in a ring buffer, so adding or removing a value takes O[1] time.
 
 The following class functions are supported:
 
@@ -170,9 +764,55 @@ function.
 
 Join() connects the outputs of the specified sequence of input queues with a new
 output queue returns the new output queue. Each value removed from each input
-queue will automatically be added to the output queue.  This pattern is useful
-when the results of the processing with a Split() function need to be
-consolidated into a single queue.
+queue will automatically be added to the output queue.  The input queues take
+turns, and an input queue drops out once it has been closed and all of its
+values removed, so the output queue is only closed once all of the input queues
+have been closed.  This pattern is useful when the results of the processing
+with a Split() function need to be consolidated into a single queue.
+
+Split() and Join() do not attach sequence numbers to the values, so a pipeline
+that uses them is unordered in general.  Both functions take turns in the same
+round-robin order, so the original order is only restored when each path
+between them produces exactly one result for each value and no input queue of
+the join closes early.  Use MapInParallel() when the results must be kept in
+their original order.
+
+Map() connects the output of the specified input queue with a new output queue
+and returns the new output queue.  The result of applying the specified mapping
+function to each value added to the input queue will be added automatically to
+the output queue.
+
+MapInParallel() connects the output of the specified input queue with a new
+output queue and returns the new output queue.  The specified mapping function
+is applied to the values added to the input queue by the number of worker
+go-routines specified by the size parameter, and the results are added to the
+output queue in the same order as the values were added to the input queue.
+Each value carries a sequence number while it is being mapped so that a result
+that finishes early can be held back until its turn.  No more than the capacity
+of the input queue values are in progress at any time, which bounds the number
+of results that are held back.  Unlike a Split() followed by a Join(), the
+workers share the values so a slow value only holds up the other workers once
+the capacity of the input queue has been reached.
+
+Filter() connects the output of the specified input queue with a new output
+queue and returns the new output queue.  Each value added to the input queue
+that satisfies the specified predicate function will be added automatically to
+the output queue.
+
+The Batch() and Window() functions that gather the values from a queue into
+lists are declared by the batcher-like class.
+
+Each of these functions runs in a go-routine belonging to the specified group
+and closes its output queues once its input queues have been closed and all of
+their values removed.  The input queues belong to the caller and are never
+connected to the group.  A function only closes its input queues when one of
+its output queues is closed by a consumer, so a pipeline built using these
+functions can be torn down by closing its last output queue.  The producers
+upstream should therefore add their values using a method that returns a status
+rather than the AddValue() method, which panics when the queue is closed.  If
+the group is torn down instead, its output queues are closed and each function
+stops without closing its input queues, so the producers upstream should watch
+the context of the group.
 */
 type QueueClassLike[V any] interface {
 	// Constructor Methods
@@ -186,6 +826,9 @@ type QueueClassLike[V any] interface {
 	QueueFromSequence(
 		values str.Sequential[V],
 	) QueueLike[V]
+	QueueFromString(
+		source string,
+	) QueueLike[V]
 
 	// Function Methods
 	Fork(
@@ -202,6 +845,22 @@ type QueueClassLike[V any] interface {
 		group Synchronized,
 		inputs str.Sequential[QueueLike[V]],
 	) QueueLike[V]
+	Map(
+		group Synchronized,
+		input QueueLike[V],
+		mapping MappingFunction[V],
+	) QueueLike[V]
+	MapInParallel(
+		group Synchronized,
+		input QueueLike[V],
+		mapping MappingFunction[V],
+		size uint,
+	) QueueLike[V]
+	Filter(
+		group Synchronized,
+		input QueueLike[V],
+		predicate PredicateFunction[V],
+	) QueueLike[V]
 }
 
 /*
@@ -213,7 +872,15 @@ A set-like class maintains an ordered sequence of unique generic typed
 values—which can grow or shrink as needed.  The order of the values is
 determined by a configurable collator agent.
 
-The following class functions are supported:
+The values of a set created by the Set() and SetWithCollator() constructors are
+maintained in a list, so adding or removing a value takes O(n) time.  The values
+of a set created by the TreeSet() and TreeSetWithCollator() constructors are
+maintained in a balanced tree instead, so adding or removing a value takes
+O[log(n)] time.  This makes tree sets better suited to large sets.  Both kinds
+of set support the same ordinal indexing of their values.
+
+The following class functions are supported.  Each returns a set of the same
+kind as the first specified set:
 
 And() returns a new set containing the values that are both of the specified
 sets.
@@ -233,12 +900,19 @@ type SetClassLike[V any] interface {
 	SetWithCollator(
 		collator age.CollatorLike[V],
 	) SetLike[V]
+	TreeSet() SetLike[V]
+	TreeSetWithCollator(
+		collator age.CollatorLike[V],
+	) SetLike[V]
 	SetFromArray(
 		values []V,
 	) SetLike[V]
 	SetFromSequence(
 		values str.Sequential[V],
 	) SetLike[V]
+	SetFromString(
+		source string,
+	) SetLike[V]
 
 	// Function Methods
 	And(
@@ -280,16 +954,19 @@ type StackClassLike[V any] interface {
 	StackFromSequence(
 		values str.Sequential[V],
 	) StackLike[V]
+	StackFromString(
+		source string,
+	) StackLike[V]
 }
 
 // INSTANCE DECLARATIONS
 
 /*
-AssociationLike[K comparable, V any] is an instance interface that declares
-the complete set of principal, attribute and aspect methods that must be
-supported by each instance of a concrete association-like class.
+AssociationLike[K any, V any] is an instance interface that declares the
+complete set of principal, attribute and aspect methods that must be supported
+by each instance of a concrete association-like class.
 */
-type AssociationLike[K comparable, V any] interface {
+type AssociationLike[K any, V any] interface {
 	// Principal Methods
 	GetClass() AssociationClassLike[K, V]
 
@@ -301,6 +978,39 @@ type AssociationLike[K comparable, V any] interface {
 	)
 }
 
+/*
+BagLike[V any] is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete bag-like class.
+
+The GetCount() method returns the number of copies of the specified value that
+are in the bag, and the SetCount() method changes that number, removing the
+value from the bag entirely when the count is zero.  The GetDistinct() method
+returns each distinct value in the bag once.  The AddValue() and RemoveValue()
+methods add or remove a single copy of a value, and the sequential methods
+treat each copy of a value as a separate value.
+*/
+type BagLike[V any] interface {
+	// Principal Methods
+	GetClass() BagClassLike[V]
+	GetCount(
+		value V,
+	) uint
+	SetCount(
+		value V,
+		count uint,
+	)
+	GetDistinct() str.Sequential[V]
+
+	// Attribute Methods
+	GetCollator() age.CollatorLike[V]
+
+	// Aspect Interfaces
+	Elastic[V]
+	str.Searchable[V]
+	str.Sequential[V]
+}
+
 /*
 CatalogLike[K comparable, V any] is an instance interface that declares
 the complete set of principal, attribute and aspect methods that must be
@@ -316,6 +1026,324 @@ type CatalogLike[K comparable, V any] interface {
 	Sortable[AssociationLike[K, V]]
 }
 
+/*
+CodecLike is an instance interface that declares the complete set of principal,
+attribute and aspect methods that must be supported by each instance of a
+concrete codec-like class.
+
+The Encode() method panics if the specified value, or any value that it
+contains, has a type that is not supported.  The Decode() method returns an
+error if the specified bytes are not exactly one encoded value in its canonical
+form, so for example a catalog containing a duplicate key is rejected.
+*/
+type CodecLike interface {
+	// Principal Methods
+	GetClass() CodecClassLike
+	Encode(
+		value any,
+	) []byte
+	Decode(
+		bytes []byte,
+	) (
+		value any,
+		err error,
+	)
+}
+
+/*
+CollectionParserLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete collection-parser-like class.
+
+The ParseCollection() method returns the collection whose literal begins at the
+start of the specified source string along with the number of bytes in that
+literal.  A sequence literal results in a ListLike[any] and a catalog literal
+results in a CatalogLike[any, any].  If the source string does not begin with a
+collection literal the collection is nil and the length is zero.
+
+The ParseValue() method returns the collection, element or string whose literal
+begins at the start of the specified source string along with the number of
+bytes in that literal.  When the literal could be either an element or a string
+the longest match wins, and an element takes precedence over a string of the
+same length.  If the source string does not begin with a literal the value is
+nil and the length is zero.
+*/
+type CollectionParserLike interface {
+	// Principal Methods
+	GetClass() CollectionParserClassLike
+	ParseCollection(
+		source string,
+	) (
+		collection any,
+		length uint,
+	)
+	ParseValue(
+		source string,
+	) (
+		value any,
+		length uint,
+	)
+}
+
+/*
+DequeLike[V any] is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete deque-like class.
+
+The GetFirst() and GetLast() methods return the value at either end of the deque
+without removing it, and the RemoveFirst() and RemoveLast() methods remove it.
+Each of these methods returns false if there is no such value.  The AddValue()
+method is the same as the AddLast() method.  The capacity of a deque that does
+not block is zero.
+*/
+type DequeLike[V any] interface {
+	// Principal Methods
+	GetClass() DequeClassLike[V]
+	AddFirst(
+		value V,
+	)
+	AddLast(
+		value V,
+	)
+	GetFirst() (
+		first V,
+		ok bool,
+	)
+	GetLast() (
+		last V,
+		ok bool,
+	)
+	RemoveLast() (
+		last V,
+		ok bool,
+	)
+
+	// Attribute Methods
+	GetCapacity() uint
+
+	// Aspect Interfaces
+	Fifo[V]
+	str.Sequential[V]
+}
+
+/*
+DictionaryLike[K any, V any] is an instance interface that declares the
+complete set of principal, attribute and aspect methods that must be supported
+by each instance of a concrete dictionary-like class.
+
+The GetFirst() and GetLast() methods return the associations with the least and
+greatest keys.  The GetFloor() method returns the association with the greatest
+key that is less than or equal to the specified key, and the GetCeiling() method
+returns the association with the least key that is greater than or equal to the
+specified key.  Each of these methods returns nil if there is no such
+association.  The GetRange() method returns a new dictionary containing copies
+of the associations whose keys lie between the specified first and last keys,
+inclusive.
+*/
+type DictionaryLike[K any, V any] interface {
+	// Principal Methods
+	GetClass() DictionaryClassLike[K, V]
+	GetFirst() AssociationLike[K, V]
+	GetLast() AssociationLike[K, V]
+	GetFloor(
+		key K,
+	) AssociationLike[K, V]
+	GetCeiling(
+		key K,
+	) AssociationLike[K, V]
+	GetRange(
+		first K,
+		last K,
+	) DictionaryLike[K, V]
+	GetValue(
+		key K,
+	) V
+	SetValue(
+		key K,
+		value V,
+	)
+	GetKeys() str.Sequential[K]
+	GetValues(
+		keys str.Sequential[K],
+	) str.Sequential[V]
+	RemoveValue(
+		key K,
+	) V
+	RemoveValues(
+		keys str.Sequential[K],
+	) str.Sequential[V]
+	RemoveAll()
+
+	// Attribute Methods
+	GetCollator() age.CollatorLike[K]
+
+	// Aspect Interfaces
+	str.Sequential[AssociationLike[K, V]]
+}
+
+/*
+GraphLike[V comparable] is an instance interface that declares the complete set
+of principal, attribute and aspect methods that must be supported by each
+instance of a concrete graph-like class.
+
+The AsCatalog() method returns a catalog that maps each node to a list of the
+target nodes of its edges.  Adding an edge adds any of its nodes that are not
+already in the graph, and removing a node removes all of its edges.  The
+BreadthFirst() and DepthFirst() methods return an iterator over the nodes that
+can be reached from the specified start node, including the start node itself,
+in breadth first or depth first (pre-order) order.
+
+The FindCycle() method returns the nodes in a cycle, starting and ending with the
+same node, or an empty sequence if the graph does not contain a cycle.  The
+SortTopologically() method returns the nodes ordered so that each edge leads
+from an earlier node to a later node.  If the graph contains a cycle the sorted
+sequence is empty and the offending cycle is returned instead.  The
+GetShortestPath() method returns the nodes on a path with the fewest edges from
+the source node to the target node, or an empty sequence if there is no path.
+The sequential methods treat the graph as a sequence of its nodes.
+*/
+type GraphLike[V comparable] interface {
+	// Principal Methods
+	GetClass() GraphClassLike[V]
+	AsCatalog() CatalogLike[V, ListLike[V]]
+	AddNode(
+		node V,
+	)
+	RemoveNode(
+		node V,
+	)
+	ContainsNode(
+		node V,
+	) bool
+	AddEdge(
+		source V,
+		target V,
+	)
+	RemoveEdge(
+		source V,
+		target V,
+	)
+	ContainsEdge(
+		source V,
+		target V,
+	) bool
+	GetEdgeCount() uint
+	GetSuccessors(
+		node V,
+	) age.IteratorLike[V]
+	GetPredecessors(
+		node V,
+	) age.IteratorLike[V]
+	BreadthFirst(
+		start V,
+	) age.IteratorLike[V]
+	DepthFirst(
+		start V,
+	) age.IteratorLike[V]
+	FindCycle() str.Sequential[V]
+	SortTopologically() (
+		sorted str.Sequential[V],
+		cycle str.Sequential[V],
+	)
+	GetShortestPath(
+		source V,
+		target V,
+	) str.Sequential[V]
+
+	// Aspect Interfaces
+	str.Sequential[V]
+}
+
+/*
+GroupLike is an instance interface that declares the complete set of principal,
+attribute and aspect methods that must be supported by each instance of a
+concrete group-like class.
+
+A go-routine in a group fails if it returns an error or panics.  When the first
+go-routine fails the context for the group is cancelled and all of the channels
+connected to the group are closed so that the remaining go-routines can finish.
+The queue class functions connect their output queues to the group
+automatically, but not the input queues that belong to the caller.  The
+WaitForError() method waits for all of the go-routines to finish and returns the
+first error, or nil if none failed, and the GetErrors() method returns all of
+the errors that have occurred so far.  If the parent context is cancelled before
+any go-routine fails its cause becomes the first error.
+
+The Wait() method keeps the signature required by the Synchronized aspect, so
+that a group can be passed to the queue class functions in place of a wait
+group, and does not return an error.  Use the WaitForError() method instead to
+find out whether any go-routine failed.  Once all of the go-routines have
+finished without the group being torn down, either method releases the
+connected channels without closing them, stops watching the parent context and
+cancels the context for the group without recording an error, so a group should
+not be reused after it has been waited on.
+*/
+type GroupLike interface {
+	// Principal Methods
+	GetClass() GroupClassLike
+	GoWithError(
+		function func() error,
+	)
+	ConnectChannel(
+		channel Closable,
+	)
+	WaitForError() error
+	GetErrors() []error
+
+	// Attribute Methods
+	GetContext() ctx.Context
+
+	// Aspect Interfaces
+	Synchronized
+}
+
+/*
+HierarchyLike[V any] is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete hierarchy-like class.
+
+The root of a hierarchy is addressed by the name with no identifiers and cannot
+hold a value.  The GetChildren() method returns the paths of the nodes directly
+beneath the specified path whether or not they hold values.  The GetSubtree()
+and RemoveSubtree() methods return a new hierarchy containing the values at and
+below the specified path using their full paths.  The MoveSubtree() method moves
+the values at and below the source path beneath the target path.  It returns
+false without making any changes if the source path does not exist, the target
+path already exists, or the target path lies beneath the source path.
+
+The WalkPrefix() method returns an iterator over the path-value associations at
+and below the specified path in depth first (pre-order) order, which is also the
+order of the sequential methods.  Removing a value also removes any of the nodes
+above it that no longer lead to a value.
+*/
+type HierarchyLike[V any] interface {
+	// Principal Methods
+	GetClass() HierarchyClassLike[V]
+	ContainsPath(
+		path str.NameLike,
+	) bool
+	GetChildren(
+		path str.NameLike,
+	) str.Sequential[str.NameLike]
+	GetSubtree(
+		path str.NameLike,
+	) HierarchyLike[V]
+	RemoveSubtree(
+		path str.NameLike,
+	) HierarchyLike[V]
+	MoveSubtree(
+		source str.NameLike,
+		target str.NameLike,
+	) bool
+	WalkPrefix(
+		prefix str.NameLike,
+	) age.IteratorLike[AssociationLike[str.NameLike, V]]
+
+	// Aspect Interfaces
+	Associative[str.NameLike, V]
+	str.Sequential[AssociationLike[str.NameLike, V]]
+}
+
 /*
 ListLike[V any] is an instance interface that declares the complete set of
 principal, attribute and aspect methods that must be supported by each
@@ -334,14 +1362,233 @@ type ListLike[V any] interface {
 	Updatable[V]
 }
 
+/*
+MultiCatalogLike[K comparable, V any] is an instance interface that declares
+the complete set of principal, attribute and aspect methods that must be
+supported by each instance of a concrete multi-catalog-like class.
+
+The AddMapping() method appends a value to the list of values for a key, and
+the RemoveMapping() method removes the first occurrence of a value from that
+list, removing the key once it has no values left.  The associative methods
+treat the list of values for each key as a single value, and the list returned
+by GetValue() is the actual list maintained by the multi-catalog.  The
+GetMappingCount() method returns the total number of values for all keys.
+*/
+type MultiCatalogLike[K comparable, V any] interface {
+	// Principal Methods
+	GetClass() MultiCatalogClassLike[K, V]
+	AddMapping(
+		key K,
+		value V,
+	)
+	ContainsMapping(
+		key K,
+		value V,
+	) bool
+	RemoveMapping(
+		key K,
+		value V,
+	)
+	GetMappingCount() uint
+
+	// Aspect Interfaces
+	Associative[K, ListLike[V]]
+	str.Sequential[AssociationLike[K, ListLike[V]]]
+	Sortable[AssociationLike[K, ListLike[V]]]
+}
+
+/*
+PersistentCatalogLike[K comparable, V any] is an instance interface that
+declares the complete set of principal, attribute and aspect methods that must
+be supported by each instance of a concrete persistent-catalog-like class.
+
+The SetValue(), RemoveValue() and RemoveValues() methods return the resulting
+persistent catalog and leave the persistent catalog on which they were called
+unchanged.  The associations returned by the AsArray() and GetIterator() methods
+are copies, so changing their values does not change the persistent catalog.
+*/
+type PersistentCatalogLike[K comparable, V any] interface {
+	// Principal Methods
+	GetClass() PersistentCatalogClassLike[K, V]
+	AsMap() map[K]V
+	GetValue(
+		key K,
+	) V
+	SetValue(
+		key K,
+		value V,
+	) PersistentCatalogLike[K, V]
+	GetKeys() str.Sequential[K]
+	GetValues(
+		keys str.Sequential[K],
+	) str.Sequential[V]
+	RemoveValue(
+		key K,
+	) PersistentCatalogLike[K, V]
+	RemoveValues(
+		keys str.Sequential[K],
+	) PersistentCatalogLike[K, V]
+
+	// Aspect Interfaces
+	str.Sequential[AssociationLike[K, V]]
+}
+
+/*
+PersistentListLike[V any] is an instance interface that declares the complete
+set of principal, attribute and aspect methods that must be supported by each
+instance of a concrete persistent-list-like class.
+
+The methods that insert, append, set or remove values return the resulting
+persistent list and leave the persistent list on which they were called
+unchanged.
+*/
+type PersistentListLike[V any] interface {
+	// Principal Methods
+	GetClass() PersistentListClassLike[V]
+	InsertValue(
+		slot uint,
+		value V,
+	) PersistentListLike[V]
+	InsertValues(
+		slot uint,
+		values str.Sequential[V],
+	) PersistentListLike[V]
+	AppendValue(
+		value V,
+	) PersistentListLike[V]
+	AppendValues(
+		values str.Sequential[V],
+	) PersistentListLike[V]
+	SetValue(
+		index int,
+		value V,
+	) PersistentListLike[V]
+	RemoveValue(
+		index int,
+	) PersistentListLike[V]
+	RemoveValues(
+		first int,
+		last int,
+	) PersistentListLike[V]
+
+	// Aspect Interfaces
+	str.Accessible[V]
+	str.Searchable[V]
+	str.Sequential[V]
+}
+
+/*
+PersistentSetLike[V any] is an instance interface that declares the complete
+set of principal, attribute and aspect methods that must be supported by each
+instance of a concrete persistent-set-like class.
+
+The methods that add or remove values return the resulting persistent set and
+leave the persistent set on which they were called unchanged.
+*/
+type PersistentSetLike[V any] interface {
+	// Principal Methods
+	GetClass() PersistentSetClassLike[V]
+	AddValue(
+		value V,
+	) PersistentSetLike[V]
+	AddValues(
+		values str.Sequential[V],
+	) PersistentSetLike[V]
+	RemoveValue(
+		value V,
+	) PersistentSetLike[V]
+	RemoveValues(
+		values str.Sequential[V],
+	) PersistentSetLike[V]
+
+	// Attribute Methods
+	GetCollator() age.CollatorLike[V]
+
+	// Aspect Interfaces
+	str.Accessible[V]
+	Ranged[V]
+	str.Searchable[V]
+	str.Sequential[V]
+}
+
+/*
+PriorityQueueLike[V any] is an instance interface that declares the complete
+set of principal, attribute and aspect methods that must be supported by each
+instance of a concrete priority-queue-like class.
+
+The GetFirst() method returns the value with the least rank without removing it
+and returns false if the priority queue is empty.  The UpdateValue() method
+replaces the first value in the priority queue that is equal to the specified
+old value with the specified updated value and moves it to its new position.
+This allows the rank of a value to be decreased (or increased) after it has
+been added.  It returns false if the old value was not found.  The values in the
+sequence returned by the AsArray() method are in the order in which they would
+be removed.  The capacity of a priority queue that does not block is zero.
+*/
+type PriorityQueueLike[V any] interface {
+	// Principal Methods
+	GetClass() PriorityQueueClassLike[V]
+	GetFirst() (
+		first V,
+		ok bool,
+	)
+	UpdateValue(
+		old V,
+		updated V,
+	) bool
+
+	// Attribute Methods
+	GetRanker() age.RankingFunction[V]
+	GetCapacity() uint
+
+	// Aspect Interfaces
+	Fifo[V]
+	str.Sequential[V]
+}
+
 /*
 QueueLike[V any] is an instance interface that declares the complete set of
 principal, attribute and aspect methods that must be supported by each
 instance of a concrete queue-like class.
+
+The following principal methods never wait indefinitely and return a status
+instead.  The TryAddValue() and TryRemoveFirst() methods return Blocked rather
+than waiting.  The methods that take a context return Cancelled or TimedOut if
+the context is done before they complete, and the methods that take a timeout
+return TimedOut if it expires first.  All of them return Closed if the queue has
+been closed (and, when removing, all of its values have been removed).  A
+method that does not return Succeeded leaves the queue unchanged.
 */
 type QueueLike[V any] interface {
 	// Principal Methods
 	GetClass() QueueClassLike[V]
+	AddValueWithContext(
+		context ctx.Context,
+		value V,
+	) Status
+	AddValueWithTimeout(
+		value V,
+		timeout ele.DurationLike,
+	) Status
+	TryAddValue(
+		value V,
+	) Status
+	RemoveFirstWithContext(
+		context ctx.Context,
+	) (
+		first V,
+		status Status,
+	)
+	RemoveFirstWithTimeout(
+		timeout ele.DurationLike,
+	) (
+		first V,
+		status Status,
+	)
+	TryRemoveFirst() (
+		first V,
+		status Status,
+	)
 
 	// Attribute Methods
 	GetCapacity() uint
@@ -366,6 +1613,7 @@ type SetLike[V any] interface {
 	// Aspect Interfaces
 	str.Accessible[V]
 	Elastic[V]
+	Ranged[V]
 	str.Searchable[V]
 	str.Sequential[V]
 }
@@ -419,6 +1667,14 @@ type Associative[K comparable, V any] interface {
 	RemoveAll()
 }
 
+/*
+Closable is an aspect interface that declares a set of method signatures that
+must be supported by each instance of a closable channel concrete class.
+*/
+type Closable interface {
+	CloseChannel()
+}
+
 /*
 Elastic[V any] is an aspect interface that declares a set of method signatures
 that must be supported by each instance of an elastic concrete class.
@@ -453,7 +1709,7 @@ type Fifo[V any] interface {
 		ok bool,
 	)
 	RemoveAll()
-	CloseChannel()
+	Closable
 }
 
 /*
@@ -499,6 +1755,23 @@ type Malleable[V any] interface {
 	RemoveAll()
 }
 
+/*
+Ranged[V any] is an aspect interface that declares a set of method signatures
+that must be supported by each instance of a sorted concrete class whose values
+may be retrieved by range.
+
+The GetValuesWithin() method returns, in order, the values that lie within the
+specified bounds, respecting whether each bracket of the bounds is inclusive or
+exclusive.  An undefined minimum or maximum leaves that end of the bounds open.
+The values are located using a binary search so retrieving k values takes
+O[log(n) + k] time.
+*/
+type Ranged[V any] interface {
+	GetValuesWithin(
+		bounds ran.Bounded[V],
+	) str.Sequential[V]
+}
+
 /*
 Sortable[V any] is an aspect interface that declares a set of method
 signatures that must be supported by each instance of a sortable concrete
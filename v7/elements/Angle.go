@@ -13,7 +13,9 @@
 package elements
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
 	mat "math"
 	reg "regexp"
@@ -59,6 +61,30 @@ func (c *angleClass_) AngleFromString(
 	}
 }
 
+func (c *angleClass_) ParseAngle(
+	source string,
+) (
+	angle AngleLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Angle", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	switch matches[1] { // Strip off the leading '~' character.
+	case "pi", "π":
+		angle = c.pi_
+	case "tau", "τ":
+		angle = c.tau_
+	default:
+		var float, _ = stc.ParseFloat(matches[1], 64)
+		angle = c.angleFromFloat(float)
+	}
+	return
+}
+
 // Constant Methods
 
 func (c *angleClass_) Undefined() AngleLike {
@@ -336,6 +362,27 @@ func (v angle_) String() string {
 	return v.AsString()
 }
 
+func (v angle_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *angle_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var angle AngleLike
+	angle, err = angleClass().ParseAngle(source)
+	if err != nil {
+		return err
+	}
+	*v = angle.(angle_)
+	return nil
+}
+
 // Private Methods
 
 func (c *angleClass_) angleFromFloat(float float64) angle_ {
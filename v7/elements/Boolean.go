@@ -13,7 +13,9 @@
 package elements
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
 	reg "regexp"
 	stc "strconv"
@@ -51,6 +53,23 @@ func (c *booleanClass_) BooleanFromString(
 	return boolean_(boolean)
 }
 
+func (c *booleanClass_) ParseBoolean(
+	source string,
+) (
+	boolean BooleanLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Boolean", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	var intrinsic, _ = stc.ParseBool(matches[0])
+	boolean = boolean_(intrinsic)
+	return
+}
+
 // Constant Methods
 
 func (c *booleanClass_) False() BooleanLike {
@@ -153,6 +172,27 @@ func (v boolean_) String() string {
 	return v.AsString()
 }
 
+func (v boolean_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *boolean_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var boolean BooleanLike
+	boolean, err = booleanClass().ParseBoolean(source)
+	if err != nil {
+		return err
+	}
+	*v = boolean.(boolean_)
+	return nil
+}
+
 // Private Methods
 
 // Instance Structure
@@ -0,0 +1,619 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	mat "math"
+	big "math/big"
+	reg "regexp"
+	stc "strconv"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func DecimalClass() DecimalClassLike {
+	return decimalClass()
+}
+
+// Constructor Methods
+
+func (c *decimalClass_) Decimal(
+	unscaled *big.Int,
+	scale int,
+) DecimalLike {
+	if uti.IsUndefined(unscaled) {
+		panic("The \"unscaled\" attribute is required by this class.")
+	}
+	return c.decimalFromParts(unscaled, scale)
+}
+
+func (c *decimalClass_) DecimalFromInteger(
+	integer int,
+) DecimalLike {
+	return decimal_(stc.Itoa(integer))
+}
+
+func (c *decimalClass_) DecimalFromFloat(
+	float float64,
+) DecimalLike {
+	if mat.IsNaN(float) || mat.IsInf(float, 0) {
+		return c.undefined_
+	}
+	// The shortest representation that uniquely identifies the float is used
+	// so that a float like 0.1 results in exactly one tenth.
+	var unscaled, scale = c.partsFromString(stc.FormatFloat(float, 'E', -1, 64))
+	return c.decimalFromParts(unscaled, scale)
+}
+
+func (c *decimalClass_) DecimalFromNumber(
+	number NumberLike,
+) DecimalLike {
+	if !number.IsDefined() || number.IsInfinite() || number.IsMinimum() ||
+		number.IsMaximum() || number.GetImaginary() != 0 {
+		// Only finite real numbers have a decimal equivalent.
+		return c.undefined_
+	}
+	return c.DecimalFromFloat(number.GetReal())
+}
+
+func (c *decimalClass_) DecimalFromString(
+	source string,
+) DecimalLike {
+	var matches = c.matcher_.FindStringSubmatch(source)
+	var decimal, ok = c.decimalFromMatches(matches)
+	if !ok {
+		var message = fmt.Sprintf(
+			"An illegal string was passed to the decimal constructor method: %s",
+			source,
+		)
+		panic(message)
+	}
+	return decimal
+}
+
+func (c *decimalClass_) ParseDecimal(
+	source string,
+) (
+	decimal DecimalLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Decimal", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	var ok bool
+	decimal, ok = c.decimalFromMatches(matches)
+	if !ok {
+		// The decimal is well formed but its exponent is out of range, either
+		// explicitly or because it has too many digits.
+		var offset = sts.Index(source, "E")
+		if offset < 0 {
+			offset = len(source) - len(sts.TrimLeft(source, "+-"))
+		}
+		decimal = nil
+		err = &age.ParseError{
+			Class:  "Decimal",
+			Source: source,
+			Offset: uint(offset),
+		}
+	}
+	return
+}
+
+// Constant Methods
+
+func (c *decimalClass_) Undefined() DecimalLike {
+	return c.undefined_
+}
+
+func (c *decimalClass_) Zero() DecimalLike {
+	return c.zero_
+}
+
+func (c *decimalClass_) One() DecimalLike {
+	return c.one_
+}
+
+func (c *decimalClass_) Precision() uint {
+	return c.precision_
+}
+
+func (c *decimalClass_) MaximumExponent() uint {
+	return c.maximumExponent_
+}
+
+// Function Methods
+
+func (c *decimalClass_) Inverse(
+	decimal DecimalLike,
+) DecimalLike {
+	if !decimal.HasMagnitude() {
+		return decimal
+	}
+	var string_ = decimal.AsString()
+	if sts.HasPrefix(string_, "-") {
+		return decimal_(string_[1:])
+	}
+	return decimal_("-" + string_)
+}
+
+func (c *decimalClass_) Reciprocal(
+	decimal DecimalLike,
+) DecimalLike {
+	return c.Quotient(c.one_, decimal)
+}
+
+func (c *decimalClass_) Sum(
+	first DecimalLike,
+	second DecimalLike,
+) DecimalLike {
+	if !first.IsDefined() || !second.IsDefined() {
+		return c.undefined_
+	}
+	var a, b, scale = c.alignedParts(first, second)
+	return c.decimalFromParts(a.Add(a, b), scale)
+}
+
+func (c *decimalClass_) Difference(
+	first DecimalLike,
+	second DecimalLike,
+) DecimalLike {
+	if !first.IsDefined() || !second.IsDefined() {
+		return c.undefined_
+	}
+	var a, b, scale = c.alignedParts(first, second)
+	return c.decimalFromParts(a.Sub(a, b), scale)
+}
+
+func (c *decimalClass_) Scaled(
+	decimal DecimalLike,
+	factor float64,
+) DecimalLike {
+	return c.Product(decimal, c.DecimalFromFloat(factor))
+}
+
+func (c *decimalClass_) Product(
+	first DecimalLike,
+	second DecimalLike,
+) DecimalLike {
+	if !first.IsDefined() || !second.IsDefined() {
+		return c.undefined_
+	}
+	var a, firstScale = c.partsFromString(first.AsString())
+	var b, secondScale = c.partsFromString(second.AsString())
+	return c.decimalFromParts(a.Mul(a, b), firstScale+secondScale)
+}
+
+// NOTE:
+// The quotient of two decimals is exact whenever it has a terminating decimal
+// expansion (e.g. 1/8 => 0.125).  Otherwise it is rounded (half to even) to
+// the number of significant digits specified by the Precision() constant.
+// Since there is no decimal infinity, a quotient with a zero divisor is
+// undefined.
+func (c *decimalClass_) Quotient(
+	first DecimalLike,
+	second DecimalLike,
+) DecimalLike {
+	if !first.IsDefined() || !second.IsDefined() || second.IsZero() {
+		return c.undefined_
+	}
+	var a, firstScale = c.partsFromString(first.AsString())
+	var b, secondScale = c.partsFromString(second.AsString())
+	var ratio = new(big.Rat).SetFrac(a, b) // This reduces the fraction.
+	var numerator = new(big.Int).Set(ratio.Num())
+	var denominator = new(big.Int).Set(ratio.Denom())
+	var scale, exact = c.terminatingScale(denominator)
+	if !exact {
+		// Retain the required number of significant digits.
+		var magnitude = new(big.Int).Abs(numerator)
+		var digits = len(magnitude.String()) - len(denominator.String())
+		scale = int(c.precision_) - digits
+	}
+	if scale < 0 {
+		denominator.Mul(denominator, c.powerOfTen(-scale))
+	} else {
+		numerator.Mul(numerator, c.powerOfTen(scale))
+	}
+	var unscaled = c.roundedQuotient(numerator, denominator)
+	return c.decimalFromParts(unscaled, scale+firstScale-secondScale)
+}
+
+// NOTE:
+// The remainder is truncated, it has the same sign as the dividend and satisfies
+// the following equation:
+//
+//	first = second * n + remainder  {n is an integer}
+func (c *decimalClass_) Remainder(
+	first DecimalLike,
+	second DecimalLike,
+) DecimalLike {
+	if !first.IsDefined() || !second.IsDefined() || second.IsZero() {
+		return c.undefined_
+	}
+	var a, b, scale = c.alignedParts(first, second)
+	return c.decimalFromParts(a.Rem(a, b), scale)
+}
+
+// NOTE:
+// Only integral exponents are supported since a fractional power of a decimal
+// is generally irrational.  A negative exponent results in the reciprocal of
+// the corresponding positive power.  The size of the power is checked before
+// it is calculated so that a large exponent cannot exhaust the memory.
+func (c *decimalClass_) Power(
+	base DecimalLike,
+	exponent DecimalLike,
+) DecimalLike {
+	if !base.IsDefined() || !exponent.IsDefined() {
+		return c.undefined_
+	}
+	var n, scale = c.partsFromString(exponent.AsString())
+	if scale > 0 || !n.IsInt64() {
+		// The exponent is not an integer of a reasonable size.
+		return c.undefined_
+	}
+	var power = n.Int64()
+	switch {
+	case power == 0:
+		// Anything to the zero power is one by definition.
+		return c.one_
+	case power < 0:
+		var reciprocal = c.Power(base, c.Inverse(exponent))
+		return c.Reciprocal(reciprocal)
+	default:
+		var a, baseScale = c.partsFromString(base.AsString())
+		var limit = int64(c.maximumExponent_)
+		if baseScale > 0 && power > limit/int64(baseScale) {
+			// The power would have too many fractional digits.
+			return c.undefined_
+		}
+		var bits = int64(new(big.Int).Abs(a).BitLen()) - 1
+		if bits > 0 && power > 8*limit/bits {
+			// The power would have too many digits (each requires over three bits).
+			return c.undefined_
+		}
+		a.Exp(a, big.NewInt(power), nil)
+		return c.decimalFromParts(a, baseScale*int(power))
+	}
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v decimal_) GetClass() DecimalClassLike {
+	return decimalClass()
+}
+
+func (v decimal_) AsIntrinsic() *big.Rat {
+	if !v.IsDefined() {
+		return nil
+	}
+	var rational, _ = new(big.Rat).SetString(string(v))
+	return rational
+}
+
+func (v decimal_) AsNumber() NumberLike {
+	if !v.IsDefined() {
+		return numberClass().Undefined()
+	}
+	return numberClass().NumberFromFloat(v.AsFloat())
+}
+
+func (v decimal_) GetUnscaled() *big.Int {
+	if !v.IsDefined() {
+		return nil
+	}
+	var unscaled, _ = decimalClass().partsFromString(string(v))
+	return unscaled
+}
+
+func (v decimal_) GetScale() int {
+	var _, scale = decimalClass().partsFromString(string(v))
+	return scale
+}
+
+// Attribute Methods
+
+// Continuous Methods
+
+func (v decimal_) AsString() string {
+	if !v.IsDefined() {
+		return "undefined"
+	}
+	return string(v)
+}
+
+func (v decimal_) AsFloat() float64 {
+	if !v.IsDefined() {
+		return mat.NaN()
+	}
+	// Any decimal that is out of range results in a signed infinity.
+	var float, _ = stc.ParseFloat(string(v), 64)
+	return float
+}
+
+func (v decimal_) HasMagnitude() bool {
+	return v.IsDefined() && !v.IsZero()
+}
+
+func (v decimal_) IsInfinite() bool {
+	return false
+}
+
+func (v decimal_) IsDefined() bool {
+	return len(v) > 0
+}
+
+func (v decimal_) IsMinimum() bool {
+	return false
+}
+
+func (v decimal_) IsZero() bool {
+	return v == decimalClass().zero_
+}
+
+func (v decimal_) IsMaximum() bool {
+	return false
+}
+
+// Polarized Methods
+
+func (v decimal_) IsNegative() bool {
+	return sts.HasPrefix(string(v), "-")
+}
+
+// PROTECTED INTERFACE
+
+func (v decimal_) String() string {
+	return v.AsString()
+}
+
+func (v decimal_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *decimal_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var decimal DecimalLike
+	decimal, err = decimalClass().ParseDecimal(source)
+	if err != nil {
+		return err
+	}
+	*v = decimal.(decimal_)
+	return nil
+}
+
+// Private Methods
+
+// This private class method returns the unscaled values of the specified
+// decimals adjusted to their largest common scale.
+func (c *decimalClass_) alignedParts(
+	first DecimalLike,
+	second DecimalLike,
+) (
+	a *big.Int,
+	b *big.Int,
+	scale int,
+) {
+	var firstScale, secondScale int
+	a, firstScale = c.partsFromString(first.AsString())
+	b, secondScale = c.partsFromString(second.AsString())
+	scale = firstScale
+	switch {
+	case firstScale < secondScale:
+		a.Mul(a, c.powerOfTen(secondScale-firstScale))
+		scale = secondScale
+	case firstScale > secondScale:
+		b.Mul(b, c.powerOfTen(firstScale-secondScale))
+	}
+	return
+}
+
+// This private class method returns the canonical decimal for the specified
+// unscaled value and scale (i.e. unscaled × 10^-scale).  The canonical string
+// contains no exponent, leading zeros or trailing fractional zeros so that two
+// decimals are equal only when their values are equal.  A decimal that is out
+// of range is undefined, which also keeps the canonical string from growing
+// without bound.
+func (c *decimalClass_) decimalFromParts(
+	unscaled *big.Int,
+	scale int,
+) DecimalLike {
+	if unscaled.Sign() == 0 {
+		return c.zero_
+	}
+	var digits = new(big.Int).Abs(unscaled).String()
+	for scale > 0 && sts.HasSuffix(digits, "0") {
+		digits = digits[:len(digits)-1]
+		scale--
+	}
+	var limit = int(c.maximumExponent_)
+	if scale > limit || len(digits)-scale > limit {
+		return c.undefined_
+	}
+	var string_ string
+	switch {
+	case scale <= 0:
+		string_ = digits + sts.Repeat("0", -scale)
+	case len(digits) <= scale:
+		string_ = "0." + sts.Repeat("0", scale-len(digits)) + digits
+	default:
+		var point = len(digits) - scale
+		string_ = digits[:point] + "." + digits[point:]
+	}
+	if unscaled.Sign() < 0 {
+		string_ = "-" + string_
+	}
+	return decimal_(string_)
+}
+
+// This private class method returns the decimal for the specified matches.  It
+// also returns whether or not the decimal is within range.
+func (c *decimalClass_) decimalFromMatches(
+	matches []string,
+) (
+	decimal DecimalLike,
+	ok bool,
+) {
+	if matches[0] == "undefined" {
+		return c.undefined_, true
+	}
+	var unscaled, scale = c.partsFromString(matches[0])
+	decimal = c.decimalFromParts(unscaled, scale)
+	ok = decimal.IsDefined()
+	return
+}
+
+// This private class method returns the unscaled value and scale for the
+// specified decimal string, which may contain a sign, fraction and exponent.
+func (c *decimalClass_) partsFromString(
+	string_ string,
+) (
+	unscaled *big.Int,
+	scale int,
+) {
+	unscaled = new(big.Int)
+	if string_ == "" || string_ == "undefined" {
+		return
+	}
+	var mantissa, exponent, _ = sts.Cut(string_, "E")
+	var negative = sts.HasPrefix(mantissa, "-")
+	mantissa = sts.TrimLeft(mantissa, "+-")
+	var whole, fraction, _ = sts.Cut(mantissa, ".")
+	unscaled.SetString(whole+fraction, 10)
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+	scale = len(fraction)
+	if len(exponent) > 0 {
+		// An exponent that is out of range is clamped so that the scale cannot
+		// overflow.  The resulting decimal is still out of range.
+		var limit = 4 * int(c.maximumExponent_)
+		var power, _ = stc.Atoi(exponent)
+		scale -= max(-limit, min(power, limit))
+	}
+	return
+}
+
+func (c *decimalClass_) powerOfTen(power int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(power)), nil)
+}
+
+// This private class method returns the quotient of the specified integers
+// rounded half to even.
+func (c *decimalClass_) roundedQuotient(
+	numerator *big.Int,
+	denominator *big.Int,
+) *big.Int {
+	var quotient, remainder = new(big.Int).QuoRem(
+		numerator,
+		denominator,
+		new(big.Int),
+	)
+	var twice = new(big.Int).Lsh(new(big.Int).Abs(remainder), 1)
+	var comparison = twice.Cmp(new(big.Int).Abs(denominator))
+	if comparison > 0 || (comparison == 0 && quotient.Bit(0) == 1) {
+		// Round away from zero.
+		if numerator.Sign()*denominator.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient
+}
+
+// This private class method determines whether or not the reciprocal of the
+// specified denominator has a terminating decimal expansion, which is the case
+// when its only prime factors are two and five.  If it does, the number of
+// decimal places in the expansion is returned as well.
+func (c *decimalClass_) terminatingScale(
+	denominator *big.Int,
+) (
+	scale int,
+	exact bool,
+) {
+	var remaining = new(big.Int).Set(denominator)
+	var twos = int(remaining.TrailingZeroBits())
+	remaining.Rsh(remaining, uint(twos))
+	var fives int
+	var five = big.NewInt(5)
+	var modulus = new(big.Int)
+	for {
+		var quotient, _ = new(big.Int).QuoRem(remaining, five, modulus)
+		if modulus.Sign() != 0 {
+			break
+		}
+		remaining = quotient
+		fives++
+	}
+	exact = remaining.Cmp(big.NewInt(1)) == 0
+	scale = max(twos, fives)
+	return
+}
+
+// NOTE:
+// These private constants are used to define the private regular expression
+// matcher that is used to match legal string patterns for this intrinsic type.
+// The decimal literal uses the real number grammar without the transcendental
+// constants or infinities since neither has an exact decimal representation.
+const (
+	exact_ = "(?:" + sign_ + ")?(?:0(?:" + fraction_ + ")?|" + ordinal_ + "(?:" + fraction_ + ")?(?:" + exponent_ + ")?)"
+)
+
+// Instance Structure
+
+type decimal_ string
+
+// Class Structure
+
+type decimalClass_ struct {
+	// Declare the class constants.
+	matcher_         *reg.Regexp
+	maximumExponent_ uint
+	precision_       uint
+	undefined_       DecimalLike
+	zero_            DecimalLike
+	one_             DecimalLike
+}
+
+// Class Reference
+
+func decimalClass() *decimalClass_ {
+	return decimalClassReference_
+}
+
+var decimalClassReference_ = &decimalClass_{
+	// Initialize the class constants.
+	matcher_:         reg.MustCompile("^(?:" + exact_ + "|" + undefined_ + ")"),
+	maximumExponent_: 6144, // The maximum exponent of an IEEE 754 decimal128 value.
+	precision_:       34,   // The precision of an IEEE 754 decimal128 value.
+	undefined_:       decimal_(""),
+	zero_:            decimal_("0"),
+	one_:             decimal_("1"),
+}
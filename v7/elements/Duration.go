@@ -13,7 +13,9 @@
 package elements
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
 	mat "math"
 	reg "regexp"
@@ -34,10 +36,7 @@ func DurationClass() DurationClassLike {
 func (c *durationClass_) Duration(
 	milliseconds int,
 ) DurationLike {
-	if milliseconds < 0 {
-		milliseconds = -milliseconds
-	}
-	return duration_(uint(milliseconds))
+	return duration_(milliseconds)
 }
 
 func (c *durationClass_) DurationFromString(
@@ -54,6 +53,22 @@ func (c *durationClass_) DurationFromString(
 	return duration_(c.durationFromMatches(matches))
 }
 
+func (c *durationClass_) ParseDuration(
+	source string,
+) (
+	duration DurationLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Duration", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	duration = duration_(c.durationFromMatches(matches))
+	return
+}
+
 // Constant Methods
 
 func (c *durationClass_) MillisecondsPerSecond() uint {
@@ -98,6 +113,61 @@ func (c *durationClass_) WeeksPerMonth() float64 {
 
 // Function Methods
 
+func (c *durationClass_) Inverse(
+	duration DurationLike,
+) DurationLike {
+	return duration_(-duration.AsIntrinsic())
+}
+
+func (c *durationClass_) Sum(
+	first DurationLike,
+	second DurationLike,
+) DurationLike {
+	return duration_(first.AsIntrinsic() + second.AsIntrinsic())
+}
+
+func (c *durationClass_) Difference(
+	first DurationLike,
+	second DurationLike,
+) DurationLike {
+	return duration_(first.AsIntrinsic() - second.AsIntrinsic())
+}
+
+func (c *durationClass_) Scaled(
+	duration DurationLike,
+	factor float64,
+) DurationLike {
+	var milliseconds = float64(duration.AsIntrinsic()) * factor
+	return duration_(mat.Round(milliseconds))
+}
+
+func (c *durationClass_) Ratio(
+	first DurationLike,
+	second DurationLike,
+) float64 {
+	return float64(first.AsIntrinsic()) / float64(second.AsIntrinsic())
+}
+
+func (c *durationClass_) Minimum(
+	first DurationLike,
+	second DurationLike,
+) DurationLike {
+	if second.AsIntrinsic() < first.AsIntrinsic() {
+		return second
+	}
+	return first
+}
+
+func (c *durationClass_) Maximum(
+	first DurationLike,
+	second DurationLike,
+) DurationLike {
+	if second.AsIntrinsic() > first.AsIntrinsic() {
+		return second
+	}
+	return first
+}
+
 // INSTANCE INTERFACE
 
 // Principal Methods
@@ -106,8 +176,8 @@ func (v duration_) GetClass() DurationClassLike {
 	return durationClass()
 }
 
-func (v duration_) AsIntrinsic() uint {
-	return uint(v)
+func (v duration_) AsIntrinsic() int {
+	return int(v)
 }
 
 // Attribute Methods
@@ -116,7 +186,11 @@ func (v duration_) AsIntrinsic() uint {
 
 func (v duration_) AsString() string {
 	var builder sts.Builder
-	builder.WriteString("~P")
+	builder.WriteString("~")
+	if v < 0 {
+		builder.WriteString("-")
+	}
+	builder.WriteString("P")
 	var float = mat.Abs(v.AsWeeks())
 	var weeks = uint(float)
 	if float64(weeks) == float {
@@ -160,8 +234,10 @@ func (v duration_) AsString() string {
 	if seconds+milliseconds > 0 {
 		builder.WriteString(stc.FormatInt(int64(seconds), 10))
 		if milliseconds > 0 {
+			// The fraction must retain its leading zeros (e.g. 0.05 seconds).
+			var fraction = momentClass().formatOrdinal(milliseconds, 3)
 			builder.WriteString(".")
-			builder.WriteString(stc.FormatInt(int64(milliseconds), 10))
+			builder.WriteString(sts.TrimRight(fraction, "0"))
 		}
 		builder.WriteString("S")
 	}
@@ -173,10 +249,12 @@ func (v duration_) AsInteger() int {
 }
 
 func (v duration_) IsDefined() bool {
-	return v > 0 && v < mat.MaxUint64
+	// A zero duration has no extent so it is not considered to be defined.
+	return v != 0 && v > mat.MinInt64 && v < mat.MaxInt64
 }
 
 func (v duration_) IsMinimum() bool {
+	// The minimum duration is the one with the shortest magnitude.
 	return v == 0
 }
 
@@ -188,6 +266,12 @@ func (v duration_) IsMaximum() bool {
 	return v == mat.MaxInt64
 }
 
+// Polarized Methods
+
+func (v duration_) IsNegative() bool {
+	return v < 0
+}
+
 // Temporal Methods
 
 func (v duration_) AsMilliseconds() float64 {
@@ -226,7 +310,7 @@ func (v duration_) AsYears() float64 {
 
 func (v duration_) GetMilliseconds() uint {
 	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	var milliseconds = v.magnitude()
 
 	// Strip off everything but the milliseconds.
 	milliseconds = milliseconds % durationClass().millisecondsPerSecond_
@@ -235,7 +319,7 @@ func (v duration_) GetMilliseconds() uint {
 
 func (v duration_) GetSeconds() uint {
 	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	var milliseconds = v.magnitude()
 
 	// Strip off the years.
 	milliseconds = milliseconds - (v.GetYears() * durationClass().millisecondsPerYear_)
@@ -259,7 +343,7 @@ func (v duration_) GetSeconds() uint {
 
 func (v duration_) GetMinutes() uint {
 	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	var milliseconds = v.magnitude()
 
 	// Strip off the years.
 	milliseconds = milliseconds - (v.GetYears() * durationClass().millisecondsPerYear_)
@@ -280,7 +364,7 @@ func (v duration_) GetMinutes() uint {
 
 func (v duration_) GetHours() uint {
 	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	var milliseconds = v.magnitude()
 
 	// Strip off the years.
 	milliseconds = milliseconds - (v.GetYears() * durationClass().millisecondsPerYear_)
@@ -298,7 +382,7 @@ func (v duration_) GetHours() uint {
 
 func (v duration_) GetDays() uint {
 	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	var milliseconds = v.magnitude()
 
 	// Strip off the years.
 	milliseconds = milliseconds - (v.GetYears() * durationClass().millisecondsPerYear_)
@@ -313,7 +397,7 @@ func (v duration_) GetDays() uint {
 
 func (v duration_) GetWeeks() uint {
 	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	var milliseconds = v.magnitude()
 
 	// Strip off the years.
 	milliseconds = milliseconds - (v.GetYears() * durationClass().millisecondsPerYear_)
@@ -325,7 +409,7 @@ func (v duration_) GetWeeks() uint {
 
 func (v duration_) GetMonths() uint {
 	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	var milliseconds = v.magnitude()
 
 	// Strip off the years.
 	milliseconds = milliseconds - (v.GetYears() * durationClass().millisecondsPerYear_)
@@ -337,7 +421,7 @@ func (v duration_) GetMonths() uint {
 
 func (v duration_) GetYears() uint {
 	// Retrieve the total number of milliseconds.
-	var milliseconds = v.AsIntrinsic()
+	var milliseconds = v.magnitude()
 
 	// Convert to years.
 	var years = milliseconds / durationClass().millisecondsPerYear_
@@ -350,15 +434,41 @@ func (v duration_) String() string {
 	return v.AsString()
 }
 
+func (v duration_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *duration_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var duration DurationLike
+	duration, err = durationClass().ParseDuration(source)
+	if err != nil {
+		return err
+	}
+	*v = duration.(duration_)
+	return nil
+}
+
 // Private Methods
 
-func (c *durationClass_) durationFromMatches(matches []string) uint {
+func (c *durationClass_) durationFromMatches(matches []string) int {
 	var milliseconds = 0.0
+	var sign = 1.0
+	if matches[1] == "-" {
+		sign = -1.0
+	}
+	matches = matches[1:] // Skip over the sign.
 	if len(matches[1]) > 0 {
 		// The duration is in weeks.
 		var float, _ = stc.ParseFloat(matches[1], 64)
 		milliseconds += float * float64(c.millisecondsPerWeek_)
-		return uint(milliseconds)
+		return int(sign * mat.Round(milliseconds))
 	}
 	if len(matches[2]) > 0 {
 		// The duration has a years component.
@@ -390,7 +500,14 @@ func (c *durationClass_) durationFromMatches(matches []string) uint {
 		var float, _ = stc.ParseFloat(matches[7], 64)
 		milliseconds += float * float64(c.millisecondsPerSecond_)
 	}
-	return uint(milliseconds)
+	return int(sign * mat.Round(milliseconds))
+}
+
+func (v duration_) magnitude() uint {
+	if v < 0 {
+		return uint(-v)
+	}
+	return uint(v)
 }
 
 // NOTE:
@@ -406,14 +523,14 @@ const (
 	minutes_  = "(" + timespan_ + ")M"
 	months_   = "(" + timespan_ + ")M"
 	seconds_  = "(" + timespan_ + ")S"
-	timespan_ = "0|" + ordinal_ + "(?:" + fraction_ + ")?"
+	timespan_ = "(?:0|" + ordinal_ + ")(?:" + fraction_ + ")?"
 	weeks_    = "(" + timespan_ + ")W"
 	years_    = "(" + timespan_ + ")Y"
 )
 
 // Instance Structure
 
-type duration_ uint
+type duration_ int
 
 // Class Structure
 
@@ -441,7 +558,7 @@ func durationClass() *durationClass_ {
 var durationClassReference_ = &durationClass_{
 	// Initialize the class constants.
 	matcher_: reg.MustCompile(
-		"^~P(?:(?:" + weeks_ + ")|(?:(?:" + years_ + ")?(?:" + months_ +
+		"^~(-)?P(?:(?:" + weeks_ + ")|(?:(?:" + years_ + ")?(?:" + months_ +
 			")?(?:" + days_ + ")?(?:T(?:" + hours_ + ")?(?:" + minutes_ +
 			")?(?:" + seconds_ + ")?)?))",
 	),
@@ -0,0 +1,204 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	reg "regexp"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func ElementParserClass() ElementParserClassLike {
+	return elementParserClass()
+}
+
+// Constructor Methods
+
+func (c *elementParserClass_) ElementParser() ElementParserLike {
+	var instance = &elementParser_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *elementParser_) GetClass() ElementParserClassLike {
+	return elementParserClass()
+}
+
+func (v *elementParser_) ParseElement(
+	source string,
+) (
+	element any,
+	length uint,
+) {
+	// Find the longest literal that matches the start of the source.
+	var class = elementParserClass()
+	var longest *literal_
+	for _, literal := range class.literals_ {
+		var size = class.matchLength(literal.matcher_, source)
+		if size > length {
+			// Earlier literals take precedence when the lengths are the same.
+			longest = literal
+			length = size
+		}
+	}
+	if longest == nil {
+		// The source does not start with an element literal.
+		return
+	}
+
+	// Construct the element using its corresponding class.
+	var err error
+	element, err = longest.constructor_(source[:length])
+	if err != nil {
+		// The literal is well formed but does not describe a legal element.
+		element = nil
+		length = 0
+	}
+	return
+}
+
+// Attribute Methods
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// This private class method returns the number of bytes at the START of the
+// specified source that are matched by the specified matcher.  Some of the
+// matchers contain alternatives that are not anchored to the start of the
+// source so any match that does not begin at the start is ignored.
+func (c *elementParserClass_) matchLength(
+	matcher *reg.Regexp,
+	source string,
+) uint {
+	var location = matcher.FindStringIndex(source)
+	if location == nil || location[0] > 0 {
+		return 0
+	}
+	return uint(location[1])
+}
+
+// This private type pairs the matcher for an element literal with the
+// constructor for its element class.
+type literal_ struct {
+	matcher_     *reg.Regexp
+	constructor_ func(source string) (any, error)
+}
+
+// Instance Structure
+
+type elementParser_ struct {
+	// Declare the instance attributes.
+}
+
+// Class Structure
+
+type elementParserClass_ struct {
+	// Declare the class constants.
+	literals_ []*literal_
+}
+
+// Class Reference
+
+func elementParserClass() *elementParserClass_ {
+	return elementParserClassReference_
+}
+
+var elementParserClassReference_ = &elementParserClass_{
+	// Initialize the class constants.
+	literals_: []*literal_{
+		{
+			matcher_: angleClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return angleClassReference_.ParseAngle(source)
+			},
+		},
+		{
+			matcher_: booleanClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return booleanClassReference_.ParseBoolean(source)
+			},
+		},
+		{
+			matcher_: durationClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				if spanClassReference_.isPrecise(source) {
+					return spanClassReference_.ParseSpan(source)
+				}
+				return durationClassReference_.ParseDuration(source)
+			},
+		},
+		{
+			matcher_: glyphClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return glyphClassReference_.ParseGlyph(source)
+			},
+		},
+		{
+			matcher_: momentClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				if spanClassReference_.isPrecise(source) {
+					return instantClassReference_.ParseInstant(source)
+				}
+				return momentClassReference_.ParseMoment(source)
+			},
+		},
+		{
+			matcher_: numberClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return numberClassReference_.ParseNumber(source)
+			},
+		},
+		{
+			matcher_: percentageClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return percentageClassReference_.ParsePercentage(source)
+			},
+		},
+		{
+			matcher_: probabilityClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return probabilityClassReference_.ParseProbability(source)
+			},
+		},
+		{
+			matcher_: rationalClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return rationalClassReference_.ParseRational(source)
+			},
+		},
+		{
+			matcher_: resourceClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return resourceClassReference_.ParseResource(source)
+			},
+		},
+		{
+			matcher_: symbolClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return symbolClassReference_.ParseSymbol(source)
+			},
+		},
+	},
+}
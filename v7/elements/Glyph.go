@@ -13,7 +13,9 @@
 package elements
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
 	mat "math"
 	reg "regexp"
@@ -58,6 +60,23 @@ func (c *glyphClass_) GlyphFromString(
 	return glyph_(rune_)
 }
 
+func (c *glyphClass_) ParseGlyph(
+	source string,
+) (
+	glyph GlyphLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Glyph", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	var rune_, _ = utf.DecodeRuneInString(matches[1]) // Strip off the single quotes.
+	glyph = glyph_(rune_)
+	return
+}
+
 // Constant Methods
 
 func (c *glyphClass_) Undefined() GlyphLike {
@@ -124,6 +143,27 @@ func (v glyph_) String() string {
 	return v.AsString()
 }
 
+func (v glyph_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *glyph_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var glyph GlyphLike
+	glyph, err = glyphClass().ParseGlyph(source)
+	if err != nil {
+		return err
+	}
+	*v = glyph.(glyph_)
+	return nil
+}
+
 // Private Methods
 
 // NOTE:
@@ -0,0 +1,313 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	tim "time"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func InstantClass() InstantClassLike {
+	return instantClass()
+}
+
+// Constructor Methods
+
+func (c *instantClass_) Instant(
+	seconds int,
+	nanoseconds int,
+) InstantLike {
+	var time = tim.Unix(int64(seconds), int64(nanoseconds)) // Normalizes them.
+	return c.InstantFromTime(time)
+}
+
+func (c *instantClass_) InstantFromMoment(
+	moment MomentLike,
+) InstantLike {
+	return c.InstantFromTime(moment.AsTime())
+}
+
+func (c *instantClass_) InstantFromTime(
+	time tim.Time,
+) InstantLike {
+	return instant_{int(time.Unix()), time.Nanosecond()}
+}
+
+func (c *instantClass_) InstantFromString(
+	source string,
+) InstantLike {
+	var matches = momentClass().matcher_.FindStringSubmatch(source)
+	if uti.IsUndefined(matches) {
+		var message = fmt.Sprintf(
+			"An illegal string was passed to the instant constructor method: %s",
+			source,
+		)
+		panic(message)
+	}
+	var time, ok = momentClass().timeFromMatches(matches)
+	if !ok {
+		var message = fmt.Sprintf(
+			"The instant does not match a known format: %v",
+			matches[0],
+		)
+		panic(message)
+	}
+	return c.InstantFromTime(time)
+}
+
+func (c *instantClass_) ParseInstant(
+	source string,
+) (
+	instant InstantLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Instant", momentClass().matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	var time, ok = momentClass().timeFromMatches(matches)
+	if !ok {
+		// The instant is well formed but does not exist on the calendar.
+		err = &age.ParseError{
+			Class:  "Instant",
+			Source: source,
+			Offset: 1, // Skip the leading '<' character.
+		}
+		return
+	}
+	instant = c.InstantFromTime(time)
+	return
+}
+
+// Constant Methods
+
+func (c *instantClass_) Epoch() InstantLike {
+	return c.epoch_
+}
+
+// Function Methods
+
+func (c *instantClass_) Now() InstantLike {
+	return c.InstantFromTime(tim.Now())
+}
+
+func (c *instantClass_) Earlier(
+	instant InstantLike,
+	span SpanLike,
+) InstantLike {
+	var time = instant.AsTime().Add(-span.AsIntrinsic())
+	return c.InstantFromTime(time)
+}
+
+func (c *instantClass_) Later(
+	instant InstantLike,
+	span SpanLike,
+) InstantLike {
+	var time = instant.AsTime().Add(span.AsIntrinsic())
+	return c.InstantFromTime(time)
+}
+
+func (c *instantClass_) Span(
+	first InstantLike,
+	second InstantLike,
+) SpanLike {
+	var span = second.AsTime().Sub(first.AsTime())
+	return spanClass().Span(span)
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v instant_) GetClass() InstantClassLike {
+	return instantClass()
+}
+
+func (v instant_) AsMoment() MomentLike {
+	return momentClass().MomentFromTime(v.AsTime())
+}
+
+func (v instant_) AsString() string {
+	return momentClass().formatTime(v.AsTime(), 9)
+}
+
+func (v instant_) AsTime() tim.Time {
+	return tim.Unix(int64(v[0]), int64(v[1])).UTC()
+}
+
+func (v instant_) AsZonedString(
+	zone *tim.Location,
+) string {
+	return momentClass().formatTime(v.AsTime().In(zone), 9)
+}
+
+func (v instant_) GetMicroseconds() uint {
+	var microseconds = v[1] / 1e3 % 1e3
+	return uint(microseconds)
+}
+
+func (v instant_) GetNanoseconds() uint {
+	var nanoseconds = v[1] % 1e3
+	return uint(nanoseconds)
+}
+
+// Attribute Methods
+
+// Polarized Methods
+
+func (v instant_) IsNegative() bool {
+	return v[0] < 0
+}
+
+// Temporal Methods
+
+func (v instant_) AsMilliseconds() float64 {
+	return float64(v[0])*float64(durationClass().millisecondsPerSecond_) +
+		float64(v[1])/float64(spanClass().nanosecondsPerMillisecond_)
+}
+
+func (v instant_) AsSeconds() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerSecond_)
+}
+
+func (v instant_) AsMinutes() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerMinute_)
+}
+
+func (v instant_) AsHours() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerHour_)
+}
+
+func (v instant_) AsDays() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerDay_)
+}
+
+func (v instant_) AsWeeks() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerWeek_)
+}
+
+func (v instant_) AsMonths() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerMonth_)
+}
+
+func (v instant_) AsYears() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerYear_)
+}
+
+// Factored Methods
+
+func (v instant_) GetMilliseconds() uint {
+	var milliseconds = v[1] / spanClass().nanosecondsPerMillisecond_
+	return uint(milliseconds)
+}
+
+func (v instant_) GetSeconds() uint {
+	var seconds = v.AsTime().Second()
+	return uint(seconds)
+}
+
+func (v instant_) GetMinutes() uint {
+	var minutes = v.AsTime().Minute()
+	return uint(minutes)
+}
+
+func (v instant_) GetHours() uint {
+	var hours = v.AsTime().Hour()
+	return uint(hours)
+}
+
+func (v instant_) GetDays() uint {
+	var days = v.AsTime().Day()
+	return uint(days)
+}
+
+func (v instant_) GetWeeks() uint {
+	var _, weeks = v.AsTime().ISOWeek()
+	return uint(weeks)
+}
+
+func (v instant_) GetMonths() uint {
+	var months = v.AsTime().Month()
+	return uint(months)
+}
+
+func (v instant_) GetYears() uint {
+	var years = v.AsTime().Year()
+	return uint(years)
+}
+
+// PROTECTED INTERFACE
+
+func (v instant_) String() string {
+	return v.AsString()
+}
+
+func (v instant_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *instant_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var instant InstantLike
+	instant, err = instantClass().ParseInstant(source)
+	if err != nil {
+		return err
+	}
+	*v = instant.(instant_)
+	return nil
+}
+
+// Private Methods
+
+// Instance Structure
+
+// NOTE:
+// An instant is stored as the number of seconds since the epoch along with the
+// number of nanoseconds within that second (always in the range [0..1e9)).  This
+// is the same representation that Go uses for its time.Time type so conversions
+// are lossless, and the ordering of the two integers is the ordering of the
+// instants.
+type instant_ [2]int
+
+// Class Structure
+
+type instantClass_ struct {
+	// Declare the class constants.
+	epoch_ InstantLike
+}
+
+// Class Reference
+
+func instantClass() *instantClass_ {
+	return instantClassReference_
+}
+
+var instantClassReference_ = &instantClass_{
+	// Initialize the class constants.
+	epoch_: instant_{0, 0},
+}
@@ -13,13 +13,16 @@
 package elements
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
 	mat "math"
 	reg "regexp"
 	stc "strconv"
 	sts "strings"
 	tim "time"
+	_ "time/tzdata" // Embed the IANA time zone database.
 )
 
 // CLASS INTERFACE
@@ -49,7 +52,47 @@ func (c *momentClass_) MomentFromString(
 		)
 		panic(message)
 	}
-	return moment_(c.momentFromMatches(matches))
+	var time, ok = c.timeFromMatches(matches)
+	if !ok {
+		var message = fmt.Sprintf(
+			"The moment does not match a known format: %v",
+			matches[0],
+		)
+		panic(message)
+	}
+	return moment_(time.UnixMilli())
+}
+
+func (c *momentClass_) ParseMoment(
+	source string,
+) (
+	moment MomentLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Moment", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	var time, ok = c.timeFromMatches(matches)
+	if !ok {
+		// The moment is well formed but does not exist on the calendar.
+		err = &age.ParseError{
+			Class:  "Moment",
+			Source: source,
+			Offset: 1, // Skip the leading '<' character.
+		}
+		return
+	}
+	moment = moment_(time.UnixMilli())
+	return
+}
+
+func (c *momentClass_) MomentFromTime(
+	time tim.Time,
+) MomentLike {
+	return moment_(time.UnixMilli())
 }
 
 // Constant Methods
@@ -83,7 +126,58 @@ func (c *momentClass_) Duration(
 	first MomentLike,
 	second MomentLike,
 ) DurationLike {
-	return durationClass().Duration(second.AsInteger() - first.AsInteger())
+	// The duration between two moments is always positive.
+	var milliseconds = second.AsInteger() - first.AsInteger()
+	if milliseconds < 0 {
+		milliseconds = -milliseconds
+	}
+	return durationClass().Duration(milliseconds)
+}
+
+func (c *momentClass_) CalendarEarlier(
+	moment MomentLike,
+	years int,
+	months int,
+	days int,
+	zone *tim.Location,
+) MomentLike {
+	return c.CalendarLater(moment, -years, -months, -days, zone)
+}
+
+func (c *momentClass_) CalendarLater(
+	moment MomentLike,
+	years int,
+	months int,
+	days int,
+	zone *tim.Location,
+) MomentLike {
+	// Determine the wall clock date and time of the moment in the time zone.
+	var time = moment.AsTime().In(zone)
+	var year, month, day = time.Date()
+	var hour, minute, second = time.Clock()
+
+	// Move to the same day in the target month, or to the last day of the target
+	// month if it is shorter (e.g. January 31st plus one month is February 28th
+	// or 29th).
+	var target = tim.Date(year+years, month+tim.Month(months), 1, 0, 0, 0, 0, zone)
+	year, month, _ = target.Date()
+	var lastDay = tim.Date(year, month+1, 0, 0, 0, 0, 0, zone).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	// The wall clock time is preserved across daylight saving time changes.
+	time = tim.Date(
+		year,
+		month,
+		day+days,
+		hour,
+		minute,
+		second,
+		time.Nanosecond(),
+		zone,
+	)
+	return moment_(time.UnixMilli())
 }
 
 // INSTANCE INTERFACE
@@ -98,47 +192,22 @@ func (v moment_) AsIntrinsic() int {
 	return int(v)
 }
 
+func (v moment_) AsTime() tim.Time {
+	return v.asTime()
+}
+
+func (v moment_) AsZonedString(
+	zone *tim.Location,
+) string {
+	return momentClass().formatTime(v.asTime().In(zone), 3)
+}
+
 // Attribute Methods
 
 // Discrete Methods
 
 func (v moment_) AsString() string {
-	var builder sts.Builder
-	var year = v.GetYears()
-	var month = v.GetMonths()
-	var day = v.GetDays()
-	var hour = v.GetHours()
-	var minute = v.GetMinutes()
-	var second = v.GetSeconds()
-	var millisecond = v.GetMilliseconds()
-	builder.WriteString("<")
-	builder.WriteString(stc.FormatInt(int64(year), 10))
-	if month > 1 || day > 1 || hour > 0 || minute > 0 || second > 0 || millisecond > 0 {
-		builder.WriteString("-")
-		builder.WriteString(momentClass().formatOrdinal(month, 2))
-		if day > 1 || hour > 0 || minute > 0 || second > 0 || millisecond > 0 {
-			builder.WriteString("-")
-			builder.WriteString(momentClass().formatOrdinal(day, 2))
-			if hour > 0 || minute > 0 || second > 0 || millisecond > 0 {
-				builder.WriteString("T")
-				builder.WriteString(momentClass().formatOrdinal(hour, 2))
-				if minute > 0 || second > 0 || millisecond > 0 {
-					builder.WriteString(":")
-					builder.WriteString(momentClass().formatOrdinal(minute, 2))
-					if second > 0 || millisecond > 0 {
-						builder.WriteString(":")
-						builder.WriteString(momentClass().formatOrdinal(second, 2))
-						if millisecond > 0 {
-							builder.WriteString(".")
-							builder.WriteString(momentClass().formatOrdinal(millisecond, 3))
-						}
-					}
-				}
-			}
-		}
-	}
-	builder.WriteString(">")
-	return builder.String()
+	return momentClass().formatTime(v.asTime(), 3)
 }
 
 func (v moment_) AsInteger() int {
@@ -257,12 +326,96 @@ func (v moment_) String() string {
 	return v.AsString()
 }
 
+func (v moment_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *moment_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var moment MomentLike
+	moment, err = momentClass().ParseMoment(source)
+	if err != nil {
+		return err
+	}
+	*v = moment.(moment_)
+	return nil
+}
+
 // Private Methods
 
 func (c *momentClass_) formatOrdinal(ordinal uint, digits int) string {
 	return fmt.Sprintf("%0"+stc.Itoa(digits)+"d", ordinal)
 }
 
+// This private class method formats the specified time as a moment literal
+// using the wall clock of its time zone.  Trailing date-time components that
+// have their minimum values are omitted, and the offset of the time zone is
+// appended unless it is zero (i.e. UTC).  Fractional seconds are truncated to
+// the specified number of digits (three, six or nine) and trailing groups of
+// three zeros are omitted.
+func (c *momentClass_) formatTime(time tim.Time, digits int) string {
+	var builder sts.Builder
+	var year = time.Year()
+	var month = uint(time.Month())
+	var day = uint(time.Day())
+	var hour = uint(time.Hour())
+	var minute = uint(time.Minute())
+	var second = uint(time.Second())
+	var precision = uint(mat.Pow10(9 - digits))
+	var fraction = uint(time.Nanosecond()) / precision
+	var _, offset = time.Zone()
+	var zoned = offset != 0 // An offset requires the hours and minutes.
+	builder.WriteString("<")
+	builder.WriteString(stc.FormatInt(int64(year), 10))
+	if zoned || month > 1 || day > 1 || hour > 0 || minute > 0 || second > 0 || fraction > 0 {
+		builder.WriteString("-")
+		builder.WriteString(c.formatOrdinal(month, 2))
+		if zoned || day > 1 || hour > 0 || minute > 0 || second > 0 || fraction > 0 {
+			builder.WriteString("-")
+			builder.WriteString(c.formatOrdinal(day, 2))
+			if zoned || hour > 0 || minute > 0 || second > 0 || fraction > 0 {
+				builder.WriteString("T")
+				builder.WriteString(c.formatOrdinal(hour, 2))
+				if zoned || minute > 0 || second > 0 || fraction > 0 {
+					builder.WriteString(":")
+					builder.WriteString(c.formatOrdinal(minute, 2))
+					if second > 0 || fraction > 0 {
+						builder.WriteString(":")
+						builder.WriteString(c.formatOrdinal(second, 2))
+						if fraction > 0 {
+							var string_ = c.formatOrdinal(fraction, digits)
+							for sts.HasSuffix(string_, "000") {
+								string_ = string_[:len(string_)-3]
+							}
+							builder.WriteString(".")
+							builder.WriteString(string_)
+						}
+					}
+				}
+			}
+		}
+	}
+	if zoned {
+		var sign = "+"
+		if offset < 0 {
+			sign = "-"
+			offset = -offset
+		}
+		builder.WriteString(sign)
+		builder.WriteString(c.formatOrdinal(uint(offset/3600), 2))
+		builder.WriteString(":")
+		builder.WriteString(c.formatOrdinal(uint(offset%3600/60), 2))
+	}
+	builder.WriteString(">")
+	return builder.String()
+}
+
 // This list contains the supported ISO 8601 date-time formats delimited by
 // angle brackets. Note: the Go templates in this list must contain their exact
 // numeric values. If you are curious why this is, check out this posting:
@@ -303,10 +456,15 @@ var hackedIsoFormats_ = [...]string{
 //	https://en.wikipedia.org/wiki/Holocene_calendar#Conversion
 //
 // we must resort to some hacking with this private function...
-func (c *momentClass_) momentFromMatches(matches []string) int {
-	// First, we replace the year with year zero.
+func (c *momentClass_) timeFromMatches(matches []string) (
+	time tim.Time,
+	ok bool,
+) {
+	// First, we remove any time zone offset and replace the year with year zero.
 	var yearString = matches[3]
-	var patched = sts.Replace(matches[1], yearString, "0000", 1)
+	var zoneString = matches[9]
+	var patched = sts.TrimSuffix(matches[1], zoneString)
+	patched = sts.Replace(patched, yearString, "0000", 1)
 
 	// Next, we attempt to parse the patched moment using our Go based formats.
 	for _, format := range hackedIsoFormats_ {
@@ -322,16 +480,36 @@ func (c *momentClass_) momentFromMatches(matches []string) int {
 				date = date.AddDate(-2*date.Year(), 0, 0)
 			}
 
-			// And return the correct date as milliseconds.
-			var milliseconds = int(date.UnixMilli())
-			return milliseconds
+			// The date was parsed as UTC so any time zone offset is removed.
+			date = date.Add(-c.offsetFromZone(zoneString))
+
+			// And return the correct date with any fractional seconds intact.
+			time = date
+			ok = true
+			return
 		}
 	}
 
-	// This panic will only happen if the regular expressions are out of sync
-	// with the ISO 8601 standard formats. The moment has already been matched
+	// This will only happen if the regular expressions are out of sync with the
+	// ISO 8601 standard formats, or the moment names a day that does not exist
+	// in its month (e.g. February 30th). The moment has already been matched
 	// succussfully.
-	panic(fmt.Sprintf("The moment does not match a known format: %v", matches[0]))
+	return
+}
+
+// This private class method returns the offset from UTC of the specified time
+// zone designator (e.g. "Z", "+01:00" or "-05:00").
+func (c *momentClass_) offsetFromZone(zone string) tim.Duration {
+	if len(zone) < 6 {
+		return 0 // There is no designator or it is "Z".
+	}
+	var hours, _ = stc.Atoi(zone[1:3])
+	var minutes, _ = stc.Atoi(zone[4:6])
+	var offset = tim.Duration(hours)*tim.Hour + tim.Duration(minutes)*tim.Minute
+	if zone[0] == '-' {
+		offset = -offset
+	}
+	return offset
 }
 
 func (v moment_) asTime() tim.Time {
@@ -352,6 +530,7 @@ const (
 	month_  = "0[1-9]|1[0-2]"
 	second_ = "[0-5][0-9]|6[0-1]"
 	year_   = "0|" + ordinal_
+	zone_   = "Z|(?:" + sign_ + ")(?:" + hour_ + "):(?:" + minute_ + ")"
 )
 
 // Instance Structure
@@ -377,7 +556,7 @@ var momentClassReference_ = &momentClass_{
 	matcher_: reg.MustCompile(
 		"^<((" + sign_ + ")?(" + year_ + ")(?:-(" + month_ + ")(?:-(" + day_ +
 			")(?:T(" + hour_ + ")(?::(" + minute_ + ")(:(?:" + second_ +
-			")(?:" + fraction_ + ")?)?)?)?)?)?)>",
+			")(?:" + fraction_ + ")?)?)?(" + zone_ + ")?)?)?)?)>",
 	),
 	epoch_: moment_(0),
 }
@@ -0,0 +1,483 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	big "math/big"
+	reg "regexp"
+	stc "strconv"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func MoneyClass() MoneyClassLike {
+	return moneyClass()
+}
+
+// Constructor Methods
+
+func (c *moneyClass_) Money(
+	currency string,
+	minorUnits int64,
+) MoneyLike {
+	var _, exists = c.currencies_[currency]
+	if !exists {
+		var message = fmt.Sprintf(
+			"An illegal currency code was passed to the money constructor method: %s",
+			currency,
+		)
+		panic(message)
+	}
+	return money_{
+		currency_: currency,
+		amount_:   minorUnits,
+	}
+}
+
+func (c *moneyClass_) MoneyFromDecimal(
+	currency string,
+	amount DecimalLike,
+) MoneyLike {
+	if uti.IsUndefined(amount) || !amount.IsDefined() {
+		panic("The \"amount\" attribute is required by this class.")
+	}
+	var _, exists = c.currencies_[currency]
+	if !exists {
+		var message = fmt.Sprintf(
+			"An illegal currency code was passed to the money constructor method: %s",
+			currency,
+		)
+		panic(message)
+	}
+	var rational = amount.AsIntrinsic()
+	rational.Mul(rational, c.minorScale(currency))
+	return c.rounded(currency, rational)
+}
+
+func (c *moneyClass_) MoneyFromString(
+	source string,
+) MoneyLike {
+	var money, err = c.ParseMoney(source)
+	if err != nil {
+		var message = fmt.Sprintf(
+			"An illegal string was passed to the money constructor method: %s",
+			source,
+		)
+		panic(message)
+	}
+	return money
+}
+
+func (c *moneyClass_) ParseMoney(
+	source string,
+) (
+	money MoneyLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Money", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	var integer = matches[1]
+	var fraction = matches[2]
+	var currency = matches[3]
+	var digits, exists = c.currencies_[currency]
+	switch {
+	case !exists:
+		// The currency code is not an ISO 4217 currency code.
+		err = &age.ParseError{
+			Class:  "Money",
+			Source: source,
+			Offset: uint(len(matches[0]) - len(currency)),
+		}
+		return
+	case len(fraction) > digits:
+		// The amount is more precise than the minor unit of the currency.
+		err = &age.ParseError{
+			Class:  "Money",
+			Source: source,
+			Offset: uint(len(integer) + 1 + digits),
+		}
+		return
+	}
+	fraction += sts.Repeat("0", digits-len(fraction))
+	var amount, _ = new(big.Int).SetString(integer+fraction, 10)
+	if !amount.IsInt64() {
+		err = &age.ParseError{
+			Class:  "Money",
+			Source: source,
+			Offset: 0,
+		}
+		return
+	}
+	money = money_{
+		currency_: currency,
+		amount_:   amount.Int64(),
+	}
+	return
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *moneyClass_) Inverse(
+	money MoneyLike,
+) MoneyLike {
+	var amount = big.NewInt(money.GetMinorUnits())
+	return c.moneyFromAmount(money.GetCurrency(), amount.Neg(amount))
+}
+
+func (c *moneyClass_) Sum(
+	first MoneyLike,
+	second MoneyLike,
+) MoneyLike {
+	var currency = c.matchingCurrency(first, second, "added")
+	var amount = big.NewInt(first.GetMinorUnits())
+	amount.Add(amount, big.NewInt(second.GetMinorUnits()))
+	return c.moneyFromAmount(currency, amount)
+}
+
+func (c *moneyClass_) Difference(
+	first MoneyLike,
+	second MoneyLike,
+) MoneyLike {
+	var currency = c.matchingCurrency(first, second, "subtracted")
+	var amount = big.NewInt(first.GetMinorUnits())
+	amount.Sub(amount, big.NewInt(second.GetMinorUnits()))
+	return c.moneyFromAmount(currency, amount)
+}
+
+// NOTE:
+// The factor is converted into the exact rational number that its shortest
+// decimal representation denotes (e.g. 1.1 is exactly eleven tenths) and the
+// scaled amount is rounded to the nearest minor unit, with ties rounded to the
+// even minor unit.
+func (c *moneyClass_) Scaled(
+	money MoneyLike,
+	factor float64,
+) MoneyLike {
+	var rational = rationalClass().RationalFromFloat(factor)
+	if !rational.IsDefined() {
+		var message = fmt.Sprintf(
+			"An illegal factor was passed to the money scaled function: %v",
+			factor,
+		)
+		panic(message)
+	}
+	var amount = rational.AsIntrinsic()
+	amount.Mul(amount, new(big.Rat).SetInt64(money.GetMinorUnits()))
+	return c.rounded(money.GetCurrency(), amount)
+}
+
+// NOTE:
+// The portion of the money is rounded to the nearest minor unit, with ties
+// rounded to the even minor unit (e.g. 7.5% of 1.00 USD is 0.08 USD).
+func (c *moneyClass_) Percentage(
+	money MoneyLike,
+	percentage PercentageLike,
+) MoneyLike {
+	var rational = rationalClass().RationalFromFloat(percentage.AsFloat())
+	if !rational.IsDefined() {
+		var message = fmt.Sprintf(
+			"An illegal percentage was passed to the money percentage function: %v",
+			percentage,
+		)
+		panic(message)
+	}
+	var amount = rational.AsIntrinsic()
+	amount.Mul(amount, new(big.Rat).SetFrac64(money.GetMinorUnits(), 100))
+	return c.rounded(money.GetCurrency(), amount)
+}
+
+// NOTE:
+// Each share is first truncated to a whole number of minor units.  The minor
+// units that remain are then handed out one at a time to the shares with
+// non-zero ratios, in order, so that the shares always add up to the original
+// amount of money and no share differs from its exact proportion by more than
+// a single minor unit.
+func (c *moneyClass_) Allocate(
+	money MoneyLike,
+	ratios []uint,
+) []MoneyLike {
+	var total = new(big.Int)
+	for _, ratio := range ratios {
+		total.Add(total, new(big.Int).SetUint64(uint64(ratio)))
+	}
+	if total.Sign() == 0 {
+		panic("The ratios passed to the money allocate function must not all be zero.")
+	}
+	var currency = money.GetCurrency()
+	var amount = money.GetMinorUnits()
+	var remainder = amount
+	var shares = make([]int64, len(ratios))
+	for index, ratio := range ratios {
+		var share = big.NewInt(amount)
+		share.Mul(share, new(big.Int).SetUint64(uint64(ratio)))
+		share.Quo(share, total)
+		shares[index] = share.Int64()
+		remainder -= shares[index]
+	}
+	var step int64 = 1
+	if remainder < 0 {
+		step = -1
+	}
+	for index := 0; remainder != 0; index++ {
+		if ratios[index] > 0 {
+			shares[index] += step
+			remainder -= step
+		}
+	}
+	var result = make([]MoneyLike, len(shares))
+	for index, share := range shares {
+		result[index] = money_{
+			currency_: currency,
+			amount_:   share,
+		}
+	}
+	return result
+}
+
+func (c *moneyClass_) Split(
+	money MoneyLike,
+	parts uint,
+) []MoneyLike {
+	if parts == 0 {
+		panic("Money cannot be split into zero parts.")
+	}
+	var ratios = make([]uint, parts)
+	for index := range ratios {
+		ratios[index] = 1
+	}
+	return c.Allocate(money, ratios)
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v money_) GetClass() MoneyClassLike {
+	return moneyClass()
+}
+
+func (v money_) AsString() string {
+	var digits = moneyClass().currencies_[v.currency_]
+	var amount = stc.FormatInt(v.amount_, 10)
+	var sign string
+	if v.amount_ < 0 {
+		sign = "-"
+		amount = amount[1:]
+	}
+	if digits > 0 {
+		if len(amount) <= digits {
+			amount = sts.Repeat("0", digits-len(amount)+1) + amount
+		}
+		var point = len(amount) - digits
+		amount = amount[:point] + "." + amount[point:]
+	}
+	return sign + amount + " " + v.currency_
+}
+
+func (v money_) AsDecimal() DecimalLike {
+	var digits = moneyClass().currencies_[v.currency_]
+	return decimalClass().Decimal(big.NewInt(v.amount_), digits)
+}
+
+func (v money_) GetCurrency() string {
+	return v.currency_
+}
+
+func (v money_) GetMinorUnits() int64 {
+	return v.amount_
+}
+
+func (v money_) IsZero() bool {
+	return v.amount_ == 0
+}
+
+// Attribute Methods
+
+// Polarized Methods
+
+func (v money_) IsNegative() bool {
+	return v.amount_ < 0
+}
+
+// PROTECTED INTERFACE
+
+func (v money_) String() string {
+	return v.AsString()
+}
+
+func (v money_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *money_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var money MoneyLike
+	money, err = moneyClass().ParseMoney(source)
+	if err != nil {
+		return err
+	}
+	*v = money.(money_)
+	return nil
+}
+
+// Private Methods
+
+// This private class method panics if the specified monies are in different
+// currencies, otherwise it returns their shared currency.
+func (c *moneyClass_) matchingCurrency(
+	first MoneyLike,
+	second MoneyLike,
+	operation string,
+) string {
+	var currency = first.GetCurrency()
+	if second.GetCurrency() != currency {
+		var message = fmt.Sprintf(
+			"Amounts in different currencies cannot be %s: %v and %v",
+			operation,
+			currency,
+			second.GetCurrency(),
+		)
+		panic(message)
+	}
+	return currency
+}
+
+// This private class method returns the number of minor units in one major
+// unit of the specified currency.
+func (c *moneyClass_) minorScale(
+	currency string,
+) *big.Rat {
+	var digits = c.currencies_[currency]
+	var scale = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	return new(big.Rat).SetInt(scale)
+}
+
+func (c *moneyClass_) moneyFromAmount(
+	currency string,
+	amount *big.Int,
+) MoneyLike {
+	if !amount.IsInt64() {
+		var message = fmt.Sprintf(
+			"The resulting amount of money cannot be represented: %v minor units of %v",
+			amount,
+			currency,
+		)
+		panic(message)
+	}
+	return money_{
+		currency_: currency,
+		amount_:   amount.Int64(),
+	}
+}
+
+// This private class method rounds the specified number of minor units to the
+// nearest whole minor unit, with ties rounded to the even minor unit.
+func (c *moneyClass_) rounded(
+	currency string,
+	minorUnits *big.Rat,
+) MoneyLike {
+	var quotient, remainder = new(big.Int).QuoRem(
+		minorUnits.Num(),
+		minorUnits.Denom(),
+		new(big.Int),
+	)
+	var twice = new(big.Int).Abs(remainder)
+	twice.Lsh(twice, 1)
+	var comparison = twice.Cmp(minorUnits.Denom())
+	if comparison > 0 || comparison == 0 && quotient.Bit(0) == 1 {
+		// Round away from zero.
+		quotient.Add(quotient, big.NewInt(int64(minorUnits.Sign())))
+	}
+	return c.moneyFromAmount(currency, quotient)
+}
+
+// NOTE:
+// These private constants are used to define the private regular expression
+// matcher that is used to match legal string patterns for this intrinsic type.
+const (
+	amount_   = "(-?(?:0|" + ordinal_ + "))(?:\\.([0-9]+))?"
+	currency_ = "([A-Z]{3})"
+)
+
+// Instance Structure
+
+type money_ struct {
+	// Declare the instance attributes.
+	currency_ string
+	amount_   int64
+}
+
+// Class Structure
+
+type moneyClass_ struct {
+	// Declare the class constants.
+	matcher_    *reg.Regexp
+	currencies_ map[string]int
+}
+
+// Class Reference
+
+func moneyClass() *moneyClass_ {
+	return moneyClassReference_
+}
+
+// NOTE:
+// Each active ISO 4217 currency code is mapped to the number of decimal digits
+// in its minor unit.
+var moneyClassReference_ = &moneyClass_{
+	// Initialize the class constants.
+	matcher_: reg.MustCompile("^" + amount_ + " " + currency_),
+	currencies_: map[string]int{
+		"AED": 2, "AFN": 2, "ALL": 2, "AMD": 2, "ANG": 2, "AOA": 2, "ARS": 2,
+		"AUD": 2, "AWG": 2, "AZN": 2, "BAM": 2, "BBD": 2, "BDT": 2, "BGN": 2,
+		"BHD": 3, "BIF": 0, "BMD": 2, "BND": 2, "BOB": 2, "BRL": 2, "BSD": 2,
+		"BTN": 2, "BWP": 2, "BYN": 2, "BZD": 2, "CAD": 2, "CDF": 2, "CHF": 2,
+		"CLP": 0, "CNY": 2, "COP": 2, "CRC": 2, "CUP": 2, "CVE": 2, "CZK": 2,
+		"DJF": 0, "DKK": 2, "DOP": 2, "DZD": 2, "EGP": 2, "ERN": 2, "ETB": 2,
+		"EUR": 2, "FJD": 2, "FKP": 2, "GBP": 2, "GEL": 2, "GHS": 2, "GIP": 2,
+		"GMD": 2, "GNF": 0, "GTQ": 2, "GYD": 2, "HKD": 2, "HNL": 2, "HTG": 2,
+		"HUF": 2, "IDR": 2, "ILS": 2, "INR": 2, "IQD": 3, "IRR": 2, "ISK": 0,
+		"JMD": 2, "JOD": 3, "JPY": 0, "KES": 2, "KGS": 2, "KHR": 2, "KMF": 0,
+		"KPW": 2, "KRW": 0, "KWD": 3, "KYD": 2, "KZT": 2, "LAK": 2, "LBP": 2,
+		"LKR": 2, "LRD": 2, "LSL": 2, "LYD": 3, "MAD": 2, "MDL": 2, "MGA": 2,
+		"MKD": 2, "MMK": 2, "MNT": 2, "MOP": 2, "MRU": 2, "MUR": 2, "MVR": 2,
+		"MWK": 2, "MXN": 2, "MYR": 2, "MZN": 2, "NAD": 2, "NGN": 2, "NIO": 2,
+		"NOK": 2, "NPR": 2, "NZD": 2, "OMR": 3, "PAB": 2, "PEN": 2, "PGK": 2,
+		"PHP": 2, "PKR": 2, "PLN": 2, "PYG": 0, "QAR": 2, "RON": 2, "RSD": 2,
+		"RUB": 2, "RWF": 0, "SAR": 2, "SBD": 2, "SCR": 2, "SDG": 2, "SEK": 2,
+		"SGD": 2, "SHP": 2, "SLE": 2, "SOS": 2, "SRD": 2, "SSP": 2, "STN": 2,
+		"SYP": 2, "SZL": 2, "THB": 2, "TJS": 2, "TMT": 2, "TND": 3, "TOP": 2,
+		"TRY": 2, "TTD": 2, "TWD": 2, "TZS": 2, "UAH": 2, "UGX": 0, "USD": 2,
+		"UYU": 2, "UZS": 2, "VES": 2, "VND": 0, "VUV": 0, "WST": 2, "XAF": 0,
+		"XCD": 2, "XOF": 0, "XPF": 0, "YER": 2, "ZAR": 2, "ZMW": 2, "ZWG": 2,
+	},
+}
@@ -13,7 +13,9 @@
 package elements
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
 	mat "math"
 	cmp "math/cmplx"
@@ -140,6 +142,23 @@ func (c *numberClass_) NumberFromString(
 	return c.normalize(complex_)
 }
 
+func (c *numberClass_) ParseNumber(
+	source string,
+) (
+	number NumberLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Number", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	var complex_ = c.complexFromMatches(matches)
+	number = c.normalize(complex_)
+	return
+}
+
 // Constant Methods
 
 func (c *numberClass_) Undefined() NumberLike {
@@ -498,6 +517,27 @@ func (v number_) String() string {
 	return v.AsString()
 }
 
+func (v number_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *number_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var number NumberLike
+	number, err = numberClass().ParseNumber(source)
+	if err != nil {
+		return err
+	}
+	*v = number.(number_)
+	return nil
+}
+
 // Private Methods
 
 // This private function returns the complex number associated with the
@@ -13,7 +13,9 @@
 package elements
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
 	mat "math"
 	reg "regexp"
@@ -58,6 +60,23 @@ func (c *percentageClass_) PercentageFromString(
 	return percentage_(float / 100.0)
 }
 
+func (c *percentageClass_) ParsePercentage(
+	source string,
+) (
+	percentage PercentageLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Percentage", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	var float, _ = stc.ParseFloat(matches[1], 64) // Strip off the '%' suffix.
+	percentage = percentage_(float / 100.0)
+	return
+}
+
 // Constant Methods
 
 func (c *percentageClass_) Undefined() PercentageLike {
@@ -126,6 +145,27 @@ func (v percentage_) String() string {
 	return v.AsString()
 }
 
+func (v percentage_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *percentage_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var percentage PercentageLike
+	percentage, err = percentageClass().ParsePercentage(source)
+	if err != nil {
+		return err
+	}
+	*v = percentage.(percentage_)
+	return nil
+}
+
 // Private Methods
 
 // Instance Structure
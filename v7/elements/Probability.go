@@ -14,7 +14,9 @@ package elements
 
 import (
 	ran "crypto/rand"
+	jsn "encoding/json"
 	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
 	mat "math"
 	big "math/big"
@@ -75,6 +77,23 @@ func (c *probabilityClass_) ProbabilityFromString(
 	return probability_(float)
 }
 
+func (c *probabilityClass_) ParseProbability(
+	source string,
+) (
+	probability ProbabilityLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Probability", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	var float, _ = stc.ParseFloat(matches[1], 64) // Strip off the leading 'p'.
+	probability = probability_(float)
+	return
+}
+
 // Constant Methods
 
 func (c *probabilityClass_) Undefined() ProbabilityLike {
@@ -183,6 +202,27 @@ func (v probability_) String() string {
 	return v.AsString()
 }
 
+func (v probability_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *probability_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var probability ProbabilityLike
+	probability, err = probabilityClass().ParseProbability(source)
+	if err != nil {
+		return err
+	}
+	*v = probability.(probability_)
+	return nil
+}
+
 // Private Methods
 
 func (c *probabilityClass_) randomInteger(max int) int {
@@ -0,0 +1,588 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	mat "math"
+	reg "regexp"
+	stc "strconv"
+	sts "strings"
+	utf "unicode/utf8"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func QuantityClass() QuantityClassLike {
+	return quantityClass()
+}
+
+// Constructor Methods
+
+func (c *quantityClass_) Quantity(
+	magnitude NumberLike,
+	units string,
+) QuantityLike {
+	if uti.IsUndefined(magnitude) {
+		panic("The \"magnitude\" attribute is required by this class.")
+	}
+	var factors, offset = c.parseUnits(units)
+	if offset >= 0 {
+		var message = fmt.Sprintf(
+			"An illegal unit expression was passed to the quantity constructor method: %s",
+			units,
+		)
+		panic(message)
+	}
+	return quantity_{
+		magnitude_: magnitude,
+		units_:     c.formatUnits(factors),
+	}
+}
+
+func (c *quantityClass_) QuantityFromString(
+	source string,
+) QuantityLike {
+	var quantity, err = c.ParseQuantity(source)
+	if err != nil {
+		var message = fmt.Sprintf(
+			"An illegal string was passed to the quantity constructor method: %s",
+			source,
+		)
+		panic(message)
+	}
+	return quantity
+}
+
+func (c *quantityClass_) ParseQuantity(
+	source string,
+) (
+	quantity QuantityLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Quantity", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	var factors, offset = c.parseUnits(matches[2])
+	if offset >= 0 {
+		// The unit expression contains an unknown unit symbol.
+		offset += len(matches[1]) + 1
+		err = &age.ParseError{
+			Class:  "Quantity",
+			Source: source,
+			Offset: uint(utf.RuneCountInString(source[:offset])),
+		}
+		return
+	}
+	quantity = quantity_{
+		magnitude_: numberClass().NumberFromString(matches[1]),
+		units_:     c.formatUnits(factors),
+	}
+	return
+}
+
+// Constant Methods
+
+// Function Methods
+
+func (c *quantityClass_) Inverse(
+	quantity QuantityLike,
+) QuantityLike {
+	return quantity_{
+		magnitude_: numberClass().Inverse(quantity.GetMagnitude()),
+		units_:     quantity.GetUnits(),
+	}
+}
+
+func (c *quantityClass_) Sum(
+	first QuantityLike,
+	second QuantityLike,
+) QuantityLike {
+	second = c.matchingUnits(first, second, "added")
+	return quantity_{
+		magnitude_: numberClass().Sum(first.GetMagnitude(), second.GetMagnitude()),
+		units_:     first.GetUnits(),
+	}
+}
+
+func (c *quantityClass_) Difference(
+	first QuantityLike,
+	second QuantityLike,
+) QuantityLike {
+	second = c.matchingUnits(first, second, "subtracted")
+	return quantity_{
+		magnitude_: numberClass().Difference(first.GetMagnitude(), second.GetMagnitude()),
+		units_:     first.GetUnits(),
+	}
+}
+
+func (c *quantityClass_) Scaled(
+	quantity QuantityLike,
+	factor float64,
+) QuantityLike {
+	return quantity_{
+		magnitude_: numberClass().Scaled(quantity.GetMagnitude(), factor),
+		units_:     quantity.GetUnits(),
+	}
+}
+
+func (c *quantityClass_) Product(
+	first QuantityLike,
+	second QuantityLike,
+) QuantityLike {
+	var factors, _ = c.parseUnits(first.GetUnits())
+	var others, _ = c.parseUnits(second.GetUnits())
+	return quantity_{
+		magnitude_: numberClass().Product(first.GetMagnitude(), second.GetMagnitude()),
+		units_:     c.formatUnits(c.combineFactors(factors, others, 1)),
+	}
+}
+
+func (c *quantityClass_) Quotient(
+	first QuantityLike,
+	second QuantityLike,
+) QuantityLike {
+	var factors, _ = c.parseUnits(first.GetUnits())
+	var others, _ = c.parseUnits(second.GetUnits())
+	return quantity_{
+		magnitude_: numberClass().Quotient(first.GetMagnitude(), second.GetMagnitude()),
+		units_:     c.formatUnits(c.combineFactors(factors, others, -1)),
+	}
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v quantity_) GetClass() QuantityClassLike {
+	return quantityClass()
+}
+
+func (v quantity_) AsUnits(
+	units string,
+) QuantityLike {
+	var class = quantityClass()
+	var target = class.Quantity(numberClass().One(), units)
+	return class.matchingUnits(target, v, "converted")
+}
+
+func (v quantity_) GetMagnitude() NumberLike {
+	return v.magnitude_
+}
+
+func (v quantity_) GetUnits() string {
+	return v.units_
+}
+
+func (v quantity_) GetDimensions() string {
+	var class = quantityClass()
+	var factors, _ = class.parseUnits(v.units_)
+	var _, dimensions = class.analyzeFactors(factors)
+	var baseFactors []factor_
+	for index, exponent := range dimensions {
+		if exponent != 0 {
+			baseFactors = append(baseFactors, factor_{
+				symbol_:   class.baseUnits_[index],
+				exponent_: exponent,
+			})
+		}
+	}
+	return class.formatUnits(baseFactors)
+}
+
+// Attribute Methods
+
+// Continuous Methods
+
+func (v quantity_) AsString() string {
+	var string_ = v.magnitude_.AsString()
+	if len(v.units_) > 0 {
+		string_ += " " + v.units_
+	}
+	return string_
+}
+
+// NOTE:
+// The float value of a quantity is its magnitude expressed in the equivalent
+// SI base units (e.g. 1 km => 1000) so that quantities with the same dimensions
+// may be compared.
+func (v quantity_) AsFloat() float64 {
+	var class = quantityClass()
+	var factors, _ = class.parseUnits(v.units_)
+	var scale, _ = class.analyzeFactors(factors)
+	return v.magnitude_.AsFloat() * scale
+}
+
+func (v quantity_) HasMagnitude() bool {
+	return v.magnitude_.HasMagnitude()
+}
+
+func (v quantity_) IsInfinite() bool {
+	return v.magnitude_.IsInfinite()
+}
+
+func (v quantity_) IsDefined() bool {
+	return v.magnitude_.IsDefined()
+}
+
+func (v quantity_) IsMinimum() bool {
+	return v.magnitude_.IsMinimum()
+}
+
+func (v quantity_) IsZero() bool {
+	return v.magnitude_.IsZero()
+}
+
+func (v quantity_) IsMaximum() bool {
+	return v.magnitude_.IsMaximum()
+}
+
+// Polarized Methods
+
+func (v quantity_) IsNegative() bool {
+	return v.magnitude_.IsNegative()
+}
+
+// PROTECTED INTERFACE
+
+func (v quantity_) String() string {
+	return v.AsString()
+}
+
+func (v quantity_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *quantity_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var quantity QuantityLike
+	quantity, err = quantityClass().ParseQuantity(source)
+	if err != nil {
+		return err
+	}
+	*v = quantity.(quantity_)
+	return nil
+}
+
+// Private Methods
+
+// This private class method returns the scale of the specified unit factors
+// relative to the SI base units along with their combined dimensions (i.e. the
+// exponents of each SI base unit).
+func (c *quantityClass_) analyzeFactors(
+	factors []factor_,
+) (
+	scale float64,
+	dimensions [7]int,
+) {
+	scale = 1.0
+	for _, factor := range factors {
+		var unit, prefix = c.lookupSymbol(factor.symbol_)
+		scale *= mat.Pow(prefix*unit.scale_, float64(factor.exponent_))
+		for index, exponent := range unit.dimensions_ {
+			dimensions[index] += exponent * factor.exponent_
+		}
+	}
+	return
+}
+
+// This private class method combines the specified unit factors, raising the
+// exponents of the second factors by the specified power (1 for a product and
+// -1 for a quotient).  Factors with the same unit symbol are merged.
+func (c *quantityClass_) combineFactors(
+	first []factor_,
+	second []factor_,
+	power int,
+) []factor_ {
+	var factors = append([]factor_{}, first...)
+	for _, other := range second {
+		var merged bool
+		for index, factor := range factors {
+			if factor.symbol_ == other.symbol_ {
+				factors[index].exponent_ += power * other.exponent_
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			factors = append(factors, factor_{
+				symbol_:   other.symbol_,
+				exponent_: power * other.exponent_,
+			})
+		}
+	}
+	return factors
+}
+
+// This private class method returns the canonical unit expression for the
+// specified unit factors.  Factors with positive exponents are separated by
+// "·" and followed by each factor with a negative exponent preceded by "/"
+// (e.g. kg·m/s^2).  Factors whose exponents cancel out are dropped.
+func (c *quantityClass_) formatUnits(
+	factors []factor_,
+) string {
+	var numerator, denominator []string
+	for _, factor := range factors {
+		var exponent = factor.exponent_
+		switch {
+		case exponent > 0:
+			numerator = append(numerator, c.formatFactor(factor.symbol_, exponent))
+		case exponent < 0:
+			denominator = append(denominator, c.formatFactor(factor.symbol_, -exponent))
+		}
+	}
+	if len(numerator) == 0 && len(denominator) > 0 {
+		// There is nothing to divide so use negative exponents instead.
+		for index, factor := range denominator {
+			var symbol, exponent, found = sts.Cut(factor, "^")
+			if !found {
+				exponent = "1"
+			}
+			denominator[index] = symbol + "^-" + exponent
+		}
+		return sts.Join(denominator, "·")
+	}
+	var units = sts.Join(numerator, "·")
+	for _, factor := range denominator {
+		units += "/" + factor
+	}
+	return units
+}
+
+func (c *quantityClass_) formatFactor(
+	symbol string,
+	exponent int,
+) string {
+	if exponent == 1 {
+		return symbol
+	}
+	return symbol + "^" + stc.Itoa(exponent)
+}
+
+// This private class method returns the unit and prefix scale for the specified
+// unit symbol.  The symbol must have already been validated.
+func (c *quantityClass_) lookupSymbol(
+	symbol string,
+) (
+	unit *unit_,
+	prefix float64,
+) {
+	var exists bool
+	unit, exists = c.units_[symbol]
+	if exists {
+		return unit, 1.0
+	}
+	for length := 1; length <= 2 && length < len(symbol); length++ {
+		// Each prefix contains at most two bytes (e.g. "da" or "µ").
+		prefix, exists = c.prefixes_[symbol[:length]]
+		if exists {
+			unit, exists = c.units_[symbol[length:]]
+			if exists {
+				return unit, prefix
+			}
+		}
+	}
+	return nil, 0
+}
+
+// This private class method returns the specified quantity converted to the
+// units of the target quantity.  It panics if the dimensions of the quantities
+// do not match.
+func (c *quantityClass_) matchingUnits(
+	target QuantityLike,
+	quantity QuantityLike,
+	operation string,
+) QuantityLike {
+	if quantity.GetUnits() == target.GetUnits() {
+		return quantity
+	}
+	var targetFactors, _ = c.parseUnits(target.GetUnits())
+	var targetScale, targetDimensions = c.analyzeFactors(targetFactors)
+	var factors, _ = c.parseUnits(quantity.GetUnits())
+	var scale, dimensions = c.analyzeFactors(factors)
+	if dimensions != targetDimensions {
+		var message = fmt.Sprintf(
+			"Quantities with different dimensions cannot be %s: %v and %v",
+			operation,
+			target.GetDimensions(),
+			quantity.GetDimensions(),
+		)
+		panic(message)
+	}
+	return quantity_{
+		magnitude_: numberClass().Scaled(quantity.GetMagnitude(), scale/targetScale),
+		units_:     target.GetUnits(),
+	}
+}
+
+// This private class method parses the specified unit expression into its unit
+// factors.  Each factor is a (possibly prefixed) unit symbol with an optional
+// integer exponent (e.g. m^2).  Factors are separated by "·" or "*" to multiply
+// them, or by "/" to divide by the next factor.  If a unit symbol is unknown its
+// byte offset in the unit expression is returned, otherwise the offset is -1.
+func (c *quantityClass_) parseUnits(
+	units string,
+) (
+	factors []factor_,
+	offset int,
+) {
+	offset = -1
+	if len(units) == 0 {
+		// The quantity is dimensionless.
+		return
+	}
+	if !c.unitsMatcher_.MatchString(units) {
+		offset = 0
+		return
+	}
+	var position int
+	var power = 1
+	for _, match := range c.factorMatcher_.FindAllStringSubmatchIndex(units, -1) {
+		var separator = units[position:match[0]]
+		if separator == "/" {
+			power = -1
+		} else {
+			power = 1
+		}
+		var symbol = units[match[2]:match[3]]
+		var unit, _ = c.lookupSymbol(symbol)
+		if unit == nil {
+			offset = match[2]
+			return
+		}
+		var exponent = 1
+		if match[4] >= 0 {
+			exponent, _ = stc.Atoi(units[match[4]:match[5]])
+		}
+		factors = c.combineFactors(factors, []factor_{{symbol, exponent}}, power)
+		position = match[1]
+	}
+	return
+}
+
+// This private type defines a unit symbol raised to an integer exponent.
+type factor_ struct {
+	symbol_   string
+	exponent_ int
+}
+
+// This private type defines the scale of a unit relative to the SI base units
+// and the exponents of each SI base unit that define its dimensions.
+type unit_ struct {
+	scale_      float64
+	dimensions_ [7]int
+}
+
+// NOTE:
+// These private constants are used to define the private regular expression
+// matcher that is used to match legal string patterns for this intrinsic type.
+const (
+	unitFactor_     = "(\\pL+)(?:\\^(-?[1-9][0-9]*))?"
+	unitExpression_ = unitFactor_ + "(?:[·*/]" + unitFactor_ + ")*"
+)
+
+// Instance Structure
+
+type quantity_ struct {
+	// Declare the instance attributes.
+	magnitude_ NumberLike
+	units_     string
+}
+
+// Class Structure
+
+type quantityClass_ struct {
+	// Declare the class constants.
+	matcher_       *reg.Regexp
+	unitsMatcher_  *reg.Regexp
+	factorMatcher_ *reg.Regexp
+	baseUnits_     [7]string
+	prefixes_      map[string]float64
+	units_         map[string]*unit_
+}
+
+// Class Reference
+
+func quantityClass() *quantityClass_ {
+	return quantityClassReference_
+}
+
+var quantityClassReference_ = &quantityClass_{
+	// Initialize the class constants.
+	matcher_:       reg.MustCompile("^(" + real_ + ")(?: (" + unitExpression_ + "))?"),
+	unitsMatcher_:  reg.MustCompile("^" + unitExpression_ + "$"),
+	factorMatcher_: reg.MustCompile(unitFactor_),
+	baseUnits_:     [7]string{"m", "kg", "s", "A", "K", "mol", "cd"},
+	prefixes_: map[string]float64{
+		"Q": 1e30, "R": 1e27, "Y": 1e24, "Z": 1e21, "E": 1e18, "P": 1e15,
+		"T": 1e12, "G": 1e9, "M": 1e6, "k": 1e3, "h": 1e2, "da": 1e1,
+		"d": 1e-1, "c": 1e-2, "m": 1e-3, "µ": 1e-6, "u": 1e-6, "n": 1e-9,
+		"p": 1e-12, "f": 1e-15, "a": 1e-18, "z": 1e-21, "y": 1e-24,
+		"r": 1e-27, "q": 1e-30,
+	},
+	units_: map[string]*unit_{
+		// The SI base units (the kilogram is a prefixed gram).
+		"m":   {1, [7]int{1, 0, 0, 0, 0, 0, 0}},
+		"g":   {1e-3, [7]int{0, 1, 0, 0, 0, 0, 0}},
+		"s":   {1, [7]int{0, 0, 1, 0, 0, 0, 0}},
+		"A":   {1, [7]int{0, 0, 0, 1, 0, 0, 0}},
+		"K":   {1, [7]int{0, 0, 0, 0, 1, 0, 0}},
+		"mol": {1, [7]int{0, 0, 0, 0, 0, 1, 0}},
+		"cd":  {1, [7]int{0, 0, 0, 0, 0, 0, 1}},
+
+		// The SI derived units.
+		"rad": {1, [7]int{0, 0, 0, 0, 0, 0, 0}},
+		"sr":  {1, [7]int{0, 0, 0, 0, 0, 0, 0}},
+		"Hz":  {1, [7]int{0, 0, -1, 0, 0, 0, 0}},
+		"N":   {1, [7]int{1, 1, -2, 0, 0, 0, 0}},
+		"Pa":  {1, [7]int{-1, 1, -2, 0, 0, 0, 0}},
+		"J":   {1, [7]int{2, 1, -2, 0, 0, 0, 0}},
+		"W":   {1, [7]int{2, 1, -3, 0, 0, 0, 0}},
+		"C":   {1, [7]int{0, 0, 1, 1, 0, 0, 0}},
+		"V":   {1, [7]int{2, 1, -3, -1, 0, 0, 0}},
+		"F":   {1, [7]int{-2, -1, 4, 2, 0, 0, 0}},
+		"Ω":   {1, [7]int{2, 1, -3, -2, 0, 0, 0}},
+		"S":   {1, [7]int{-2, -1, 3, 2, 0, 0, 0}},
+		"Wb":  {1, [7]int{2, 1, -2, -1, 0, 0, 0}},
+		"T":   {1, [7]int{0, 1, -2, -1, 0, 0, 0}},
+		"H":   {1, [7]int{2, 1, -2, -2, 0, 0, 0}},
+		"lm":  {1, [7]int{0, 0, 0, 0, 0, 0, 1}},
+		"lx":  {1, [7]int{-2, 0, 0, 0, 0, 0, 1}},
+		"Bq":  {1, [7]int{0, 0, -1, 0, 0, 0, 0}},
+		"Gy":  {1, [7]int{2, 0, -2, 0, 0, 0, 0}},
+		"Sv":  {1, [7]int{2, 0, -2, 0, 0, 0, 0}},
+		"kat": {1, [7]int{0, 0, -1, 0, 0, 1, 0}},
+
+		// The non-SI units that are accepted for use with the SI units.
+		"min": {60, [7]int{0, 0, 1, 0, 0, 0, 0}},
+		"h":   {3600, [7]int{0, 0, 1, 0, 0, 0, 0}},
+		"d":   {86400, [7]int{0, 0, 1, 0, 0, 0, 0}},
+		"L":   {1e-3, [7]int{3, 0, 0, 0, 0, 0, 0}},
+		"t":   {1e3, [7]int{0, 1, 0, 0, 0, 0, 0}},
+		"eV":  {1.602176634e-19, [7]int{2, 1, -2, 0, 0, 0, 0}},
+	},
+}
@@ -0,0 +1,436 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	mat "math"
+	big "math/big"
+	reg "regexp"
+	stc "strconv"
+	sts "strings"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func RationalClass() RationalClassLike {
+	return rationalClass()
+}
+
+// Constructor Methods
+
+func (c *rationalClass_) Rational(
+	rational *big.Rat,
+) RationalLike {
+	if uti.IsUndefined(rational) {
+		panic("The \"rational\" attribute is required by this class.")
+	}
+	return rational_(rational.String())
+}
+
+func (c *rationalClass_) RationalFromFraction(
+	numerator int,
+	denominator int,
+) RationalLike {
+	if denominator == 0 {
+		// There is no rational infinity.
+		return c.undefined_
+	}
+	var rational = big.NewRat(int64(numerator), int64(denominator))
+	return rational_(rational.String())
+}
+
+func (c *rationalClass_) RationalFromInteger(
+	integer int,
+) RationalLike {
+	return c.RationalFromFraction(integer, 1)
+}
+
+func (c *rationalClass_) RationalFromFloat(
+	float float64,
+) RationalLike {
+	if mat.IsNaN(float) || mat.IsInf(float, 0) {
+		return c.undefined_
+	}
+	// The shortest representation that uniquely identifies the float is used
+	// so that a float like 0.1 results in exactly one tenth.
+	var rational, _ = new(big.Rat).SetString(stc.FormatFloat(float, 'E', -1, 64))
+	return rational_(rational.String())
+}
+
+func (c *rationalClass_) RationalFromDecimal(
+	decimal DecimalLike,
+) RationalLike {
+	if !decimal.IsDefined() {
+		return c.undefined_
+	}
+	return rational_(decimal.AsIntrinsic().String())
+}
+
+func (c *rationalClass_) RationalFromString(
+	source string,
+) RationalLike {
+	var matches = c.matcher_.FindStringSubmatch(source)
+	if uti.IsUndefined(matches) {
+		var message = fmt.Sprintf(
+			"An illegal string was passed to the rational constructor method: %s",
+			source,
+		)
+		panic(message)
+	}
+	return c.rationalFromMatches(matches)
+}
+
+func (c *rationalClass_) ParseRational(
+	source string,
+) (
+	rational RationalLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Rational", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	rational = c.rationalFromMatches(matches)
+	return
+}
+
+// Constant Methods
+
+func (c *rationalClass_) Undefined() RationalLike {
+	return c.undefined_
+}
+
+func (c *rationalClass_) Zero() RationalLike {
+	return c.zero_
+}
+
+func (c *rationalClass_) One() RationalLike {
+	return c.one_
+}
+
+// Function Methods
+
+func (c *rationalClass_) Inverse(
+	rational RationalLike,
+) RationalLike {
+	if !rational.IsDefined() {
+		return c.undefined_
+	}
+	var inverse = rational.AsIntrinsic()
+	inverse.Neg(inverse)
+	return rational_(inverse.String())
+}
+
+func (c *rationalClass_) Reciprocal(
+	rational RationalLike,
+) RationalLike {
+	if !rational.HasMagnitude() {
+		// There is no reciprocal of zero.
+		return c.undefined_
+	}
+	var reciprocal = rational.AsIntrinsic()
+	reciprocal.Inv(reciprocal)
+	return rational_(reciprocal.String())
+}
+
+func (c *rationalClass_) Sum(
+	first RationalLike,
+	second RationalLike,
+) RationalLike {
+	if !first.IsDefined() || !second.IsDefined() {
+		return c.undefined_
+	}
+	var sum = first.AsIntrinsic()
+	sum.Add(sum, second.AsIntrinsic())
+	return rational_(sum.String())
+}
+
+func (c *rationalClass_) Difference(
+	first RationalLike,
+	second RationalLike,
+) RationalLike {
+	if !first.IsDefined() || !second.IsDefined() {
+		return c.undefined_
+	}
+	var difference = first.AsIntrinsic()
+	difference.Sub(difference, second.AsIntrinsic())
+	return rational_(difference.String())
+}
+
+func (c *rationalClass_) Scaled(
+	rational RationalLike,
+	factor float64,
+) RationalLike {
+	return c.Product(rational, c.RationalFromFloat(factor))
+}
+
+func (c *rationalClass_) Product(
+	first RationalLike,
+	second RationalLike,
+) RationalLike {
+	if !first.IsDefined() || !second.IsDefined() {
+		return c.undefined_
+	}
+	var product = first.AsIntrinsic()
+	product.Mul(product, second.AsIntrinsic())
+	return rational_(product.String())
+}
+
+func (c *rationalClass_) Quotient(
+	first RationalLike,
+	second RationalLike,
+) RationalLike {
+	if !first.IsDefined() || !second.HasMagnitude() {
+		// Any undefined arguments or a zero divisor result in an undefined result.
+		return c.undefined_
+	}
+	var quotient = first.AsIntrinsic()
+	quotient.Quo(quotient, second.AsIntrinsic())
+	return rational_(quotient.String())
+}
+
+// NOTE:
+// The remainder is truncated, it has the same sign as the dividend and satisfies
+// the following equation:
+//
+//	first = second * n + remainder  {n is an integer}
+func (c *rationalClass_) Remainder(
+	first RationalLike,
+	second RationalLike,
+) RationalLike {
+	if !first.IsDefined() || !second.HasMagnitude() {
+		// Any undefined arguments or a zero divisor result in an undefined result.
+		return c.undefined_
+	}
+	var dividend = first.AsIntrinsic()
+	var divisor = second.AsIntrinsic()
+	var quotient = new(big.Rat).Quo(dividend, divisor)
+	var truncated = new(big.Int).Quo(quotient.Num(), quotient.Denom())
+	var remainder = new(big.Rat).SetInt(truncated)
+	remainder.Mul(remainder, divisor)
+	remainder.Sub(dividend, remainder)
+	return rational_(remainder.String())
+}
+
+// NOTE:
+// Only integral exponents are supported since a fractional power of a rational
+// number is generally irrational.  A negative exponent results in the reciprocal
+// of the corresponding positive power.
+func (c *rationalClass_) Power(
+	base RationalLike,
+	exponent RationalLike,
+) RationalLike {
+	if !base.IsDefined() || !exponent.IsDefined() {
+		return c.undefined_
+	}
+	var power = exponent.AsIntrinsic()
+	if !power.IsInt() || !power.Num().IsInt64() {
+		// The exponent is not an integer of a reasonable size.
+		return c.undefined_
+	}
+	var n = power.Num().Int64()
+	switch {
+	case n == 0:
+		// Anything to the zero power is one by definition.
+		return c.one_
+	case n < 0:
+		var reciprocal = c.Power(base, c.Inverse(exponent))
+		return c.Reciprocal(reciprocal)
+	default:
+		var value = base.AsIntrinsic()
+		var numerator = new(big.Int).Exp(value.Num(), big.NewInt(n), nil)
+		var denominator = new(big.Int).Exp(value.Denom(), big.NewInt(n), nil)
+		var result = new(big.Rat).SetFrac(numerator, denominator)
+		return rational_(result.String())
+	}
+}
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v rational_) GetClass() RationalClassLike {
+	return rationalClass()
+}
+
+func (v rational_) AsIntrinsic() *big.Rat {
+	if !v.IsDefined() {
+		return nil
+	}
+	var rational, _ = new(big.Rat).SetString(string(v))
+	return rational
+}
+
+func (v rational_) AsDecimal() DecimalLike {
+	if !v.IsDefined() {
+		return decimalClass().Undefined()
+	}
+	var rational = v.AsIntrinsic()
+	var class = decimalClass()
+	return class.Quotient(
+		class.Decimal(rational.Num(), 0),
+		class.Decimal(rational.Denom(), 0),
+	)
+}
+
+func (v rational_) AsNumber() NumberLike {
+	if !v.IsDefined() {
+		return numberClass().Undefined()
+	}
+	return numberClass().NumberFromFloat(v.AsFloat())
+}
+
+func (v rational_) GetNumerator() *big.Int {
+	if !v.IsDefined() {
+		return nil
+	}
+	return v.AsIntrinsic().Num()
+}
+
+func (v rational_) GetDenominator() *big.Int {
+	if !v.IsDefined() {
+		return nil
+	}
+	return v.AsIntrinsic().Denom()
+}
+
+// Attribute Methods
+
+// Continuous Methods
+
+func (v rational_) AsString() string {
+	if !v.IsDefined() {
+		return "undefined"
+	}
+	return string(v)
+}
+
+func (v rational_) AsFloat() float64 {
+	if !v.IsDefined() {
+		return mat.NaN()
+	}
+	var float, _ = v.AsIntrinsic().Float64()
+	return float
+}
+
+func (v rational_) HasMagnitude() bool {
+	return v.IsDefined() && !v.IsZero()
+}
+
+func (v rational_) IsInfinite() bool {
+	return false
+}
+
+func (v rational_) IsDefined() bool {
+	return len(v) > 0
+}
+
+func (v rational_) IsMinimum() bool {
+	return false
+}
+
+func (v rational_) IsZero() bool {
+	return v == rationalClass().zero_
+}
+
+func (v rational_) IsMaximum() bool {
+	return false
+}
+
+// Polarized Methods
+
+func (v rational_) IsNegative() bool {
+	return sts.HasPrefix(string(v), "-")
+}
+
+// PROTECTED INTERFACE
+
+func (v rational_) String() string {
+	return v.AsString()
+}
+
+func (v rational_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *rational_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var rational RationalLike
+	rational, err = rationalClass().ParseRational(source)
+	if err != nil {
+		return err
+	}
+	*v = rational.(rational_)
+	return nil
+}
+
+// Private Methods
+
+func (c *rationalClass_) rationalFromMatches(matches []string) RationalLike {
+	if matches[0] == "undefined" {
+		return c.undefined_
+	}
+	// The canonical form of a rational number is reduced to its lowest terms.
+	var rational, _ = new(big.Rat).SetString(matches[0])
+	return rational_(rational.String())
+}
+
+// NOTE:
+// These private constants are used to define the private regular expression
+// matcher that is used to match legal string patterns for this intrinsic type.
+// The denominator of a rational literal may not be zero.
+const (
+	ratio_ = "(?:" + sign_ + ")?(?:0|" + ordinal_ + ")/" + ordinal_
+)
+
+// Instance Structure
+
+type rational_ string
+
+// Class Structure
+
+type rationalClass_ struct {
+	// Declare the class constants.
+	matcher_   *reg.Regexp
+	undefined_ RationalLike
+	zero_      RationalLike
+	one_       RationalLike
+}
+
+// Class Reference
+
+func rationalClass() *rationalClass_ {
+	return rationalClassReference_
+}
+
+var rationalClassReference_ = &rationalClass_{
+	// Initialize the class constants.
+	matcher_:   reg.MustCompile("^(?:" + ratio_ + "|" + undefined_ + ")"),
+	undefined_: rational_(""),
+	zero_:      rational_("0/1"),
+	one_:       rational_("1/1"),
+}
@@ -13,7 +13,9 @@
 package elements
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
 	uri "net/url"
 	reg "regexp"
@@ -49,6 +51,22 @@ func (c *resourceClass_) ResourceFromString(
 	return resource_(matches[1]) // Strip off the angle brackets.
 }
 
+func (c *resourceClass_) ParseResource(
+	source string,
+) (
+	resource ResourceLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Resource", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	resource = resource_(matches[1]) // Strip off the angle brackets.
+	return
+}
+
 func (c *resourceClass_) ResourceFromUri(
 	url *uri.URL,
 ) ResourceLike {
@@ -124,6 +142,27 @@ func (v resource_) String() string {
 	return v.AsString()
 }
 
+func (v resource_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *resource_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var resource ResourceLike
+	resource, err = resourceClass().ParseResource(source)
+	if err != nil {
+		return err
+	}
+	*v = resource.(resource_)
+	return nil
+}
+
 // Private Methods
 
 // NOTE:
@@ -0,0 +1,449 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package elements
+
+import (
+	jsn "encoding/json"
+	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
+	uti "github.com/craterdog/go-missing-utilities/v7"
+	mat "math"
+	reg "regexp"
+	stc "strconv"
+	sts "strings"
+	tim "time"
+	uni "unicode"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func SpanClass() SpanClassLike {
+	return spanClass()
+}
+
+// Constructor Methods
+
+func (c *spanClass_) Span(
+	duration tim.Duration,
+) SpanLike {
+	return span_(duration)
+}
+
+func (c *spanClass_) SpanFromDuration(
+	duration DurationLike,
+) SpanLike {
+	var nanoseconds = duration.AsInteger() * c.nanosecondsPerMillisecond_
+	return span_(nanoseconds)
+}
+
+func (c *spanClass_) SpanFromString(
+	source string,
+) SpanLike {
+	var matches = c.matcher_.FindStringSubmatch(source)
+	if uti.IsUndefined(matches) {
+		var message = fmt.Sprintf(
+			"An illegal string was passed to the span constructor method: %s",
+			source,
+		)
+		panic(message)
+	}
+	return span_(c.spanFromMatches(matches))
+}
+
+func (c *spanClass_) ParseSpan(
+	source string,
+) (
+	span SpanLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Span", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	span = span_(c.spanFromMatches(matches))
+	return
+}
+
+// Constant Methods
+
+func (c *spanClass_) NanosecondsPerMicrosecond() int {
+	return c.nanosecondsPerMicrosecond_
+}
+
+func (c *spanClass_) NanosecondsPerMillisecond() int {
+	return c.nanosecondsPerMillisecond_
+}
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v span_) GetClass() SpanClassLike {
+	return spanClass()
+}
+
+func (v span_) AsIntrinsic() tim.Duration {
+	return tim.Duration(v)
+}
+
+func (v span_) AsDuration() DurationLike {
+	var milliseconds = int(v) / spanClass().nanosecondsPerMillisecond_
+	return durationClass().Duration(milliseconds)
+}
+
+func (v span_) GetMicroseconds() uint {
+	var nanoseconds = v.magnitude() % uint(spanClass().nanosecondsPerMillisecond_)
+	return nanoseconds / uint(spanClass().nanosecondsPerMicrosecond_)
+}
+
+func (v span_) GetNanoseconds() uint {
+	return v.magnitude() % uint(spanClass().nanosecondsPerMicrosecond_)
+}
+
+// Attribute Methods
+
+// Discrete Methods
+
+func (v span_) AsString() string {
+	var builder sts.Builder
+	builder.WriteString("~")
+	if v < 0 {
+		builder.WriteString("-")
+	}
+	builder.WriteString("P")
+	var magnitude = v.magnitude()
+	var nanosecondsPerWeek = spanClass().nanosecondsPer(durationClass().millisecondsPerWeek_)
+	if magnitude%nanosecondsPerWeek == 0 {
+		// It is an exact number of weeks.
+		builder.WriteString(stc.FormatUint(uint64(magnitude/nanosecondsPerWeek), 10))
+		builder.WriteString("W")
+		return builder.String()
+	}
+	var years = v.GetYears()
+	if years > 0 {
+		builder.WriteString(stc.FormatUint(uint64(years), 10))
+		builder.WriteString("Y")
+	}
+	var months = v.GetMonths()
+	if months > 0 {
+		builder.WriteString(stc.FormatUint(uint64(months), 10))
+		builder.WriteString("M")
+	}
+	var days = v.GetDays()
+	if days > 0 {
+		builder.WriteString(stc.FormatUint(uint64(days), 10))
+		builder.WriteString("D")
+	}
+	var hours = v.GetHours()
+	var minutes = v.GetMinutes()
+	var seconds = v.GetSeconds()
+	var nanoseconds = magnitude % spanClass().nanosecondsPer(durationClass().millisecondsPerSecond_)
+	if hours+minutes+seconds+nanoseconds == 0 {
+		// There is no time part of the span.
+		return builder.String()
+	}
+	builder.WriteString("T")
+	if hours > 0 {
+		builder.WriteString(stc.FormatUint(uint64(hours), 10))
+		builder.WriteString("H")
+	}
+	if minutes > 0 {
+		builder.WriteString(stc.FormatUint(uint64(minutes), 10))
+		builder.WriteString("M")
+	}
+	if seconds+nanoseconds > 0 {
+		builder.WriteString(stc.FormatUint(uint64(seconds), 10))
+		if nanoseconds > 0 {
+			// The fraction must retain its leading zeros (e.g. 0.05 seconds).
+			var fraction = momentClass().formatOrdinal(nanoseconds, 9)
+			builder.WriteString(".")
+			builder.WriteString(sts.TrimRight(fraction, "0"))
+		}
+		builder.WriteString("S")
+	}
+	return builder.String()
+}
+
+func (v span_) AsInteger() int {
+	return int(v)
+}
+
+func (v span_) IsDefined() bool {
+	return v > mat.MinInt64 && v < mat.MaxInt64
+}
+
+func (v span_) IsMinimum() bool {
+	return v == mat.MinInt64
+}
+
+func (v span_) IsZero() bool {
+	return v == 0
+}
+
+func (v span_) IsMaximum() bool {
+	return v == mat.MaxInt64
+}
+
+// Polarized Methods
+
+func (v span_) IsNegative() bool {
+	return v < 0
+}
+
+// Temporal Methods
+
+func (v span_) AsMilliseconds() float64 {
+	return float64(v) / float64(spanClass().nanosecondsPerMillisecond_)
+}
+
+func (v span_) AsSeconds() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerSecond_)
+}
+
+func (v span_) AsMinutes() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerMinute_)
+}
+
+func (v span_) AsHours() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerHour_)
+}
+
+func (v span_) AsDays() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerDay_)
+}
+
+func (v span_) AsWeeks() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerWeek_)
+}
+
+func (v span_) AsMonths() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerMonth_)
+}
+
+func (v span_) AsYears() float64 {
+	return v.AsMilliseconds() / float64(durationClass().millisecondsPerYear_)
+}
+
+// Factored Methods
+
+func (v span_) GetMilliseconds() uint {
+	var nanoseconds = v.magnitude() % spanClass().nanosecondsPer(durationClass().millisecondsPerSecond_)
+	return nanoseconds / uint(spanClass().nanosecondsPerMillisecond_)
+}
+
+func (v span_) GetSeconds() uint {
+	// Strip off everything above the minutes.
+	var nanoseconds = v.magnitude() % spanClass().nanosecondsPer(durationClass().millisecondsPerYear_)
+	nanoseconds = nanoseconds % spanClass().nanosecondsPer(durationClass().millisecondsPerMonth_)
+	nanoseconds = nanoseconds % spanClass().nanosecondsPer(durationClass().millisecondsPerDay_)
+	nanoseconds = nanoseconds % spanClass().nanosecondsPer(durationClass().millisecondsPerHour_)
+	nanoseconds = nanoseconds % spanClass().nanosecondsPer(durationClass().millisecondsPerMinute_)
+
+	// Convert to seconds.
+	return nanoseconds / spanClass().nanosecondsPer(durationClass().millisecondsPerSecond_)
+}
+
+func (v span_) GetMinutes() uint {
+	// Strip off everything above the hours.
+	var nanoseconds = v.magnitude() % spanClass().nanosecondsPer(durationClass().millisecondsPerYear_)
+	nanoseconds = nanoseconds % spanClass().nanosecondsPer(durationClass().millisecondsPerMonth_)
+	nanoseconds = nanoseconds % spanClass().nanosecondsPer(durationClass().millisecondsPerDay_)
+	nanoseconds = nanoseconds % spanClass().nanosecondsPer(durationClass().millisecondsPerHour_)
+
+	// Convert to minutes.
+	return nanoseconds / spanClass().nanosecondsPer(durationClass().millisecondsPerMinute_)
+}
+
+func (v span_) GetHours() uint {
+	// Strip off everything above the days.
+	var nanoseconds = v.magnitude() % spanClass().nanosecondsPer(durationClass().millisecondsPerYear_)
+	nanoseconds = nanoseconds % spanClass().nanosecondsPer(durationClass().millisecondsPerMonth_)
+	nanoseconds = nanoseconds % spanClass().nanosecondsPer(durationClass().millisecondsPerDay_)
+
+	// Convert to hours.
+	return nanoseconds / spanClass().nanosecondsPer(durationClass().millisecondsPerHour_)
+}
+
+func (v span_) GetDays() uint {
+	// Strip off the years and months.
+	var nanoseconds = v.magnitude() % spanClass().nanosecondsPer(durationClass().millisecondsPerYear_)
+	nanoseconds = nanoseconds % spanClass().nanosecondsPer(durationClass().millisecondsPerMonth_)
+
+	// Convert to days.
+	return nanoseconds / spanClass().nanosecondsPer(durationClass().millisecondsPerDay_)
+}
+
+func (v span_) GetWeeks() uint {
+	// Strip off the years.
+	var nanoseconds = v.magnitude() % spanClass().nanosecondsPer(durationClass().millisecondsPerYear_)
+
+	// Convert to weeks.
+	return nanoseconds / spanClass().nanosecondsPer(durationClass().millisecondsPerWeek_)
+}
+
+func (v span_) GetMonths() uint {
+	// Strip off the years.
+	var nanoseconds = v.magnitude() % spanClass().nanosecondsPer(durationClass().millisecondsPerYear_)
+
+	// Convert to months.
+	return nanoseconds / spanClass().nanosecondsPer(durationClass().millisecondsPerMonth_)
+}
+
+func (v span_) GetYears() uint {
+	return v.magnitude() / spanClass().nanosecondsPer(durationClass().millisecondsPerYear_)
+}
+
+// PROTECTED INTERFACE
+
+func (v span_) String() string {
+	return v.AsString()
+}
+
+func (v span_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *span_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var span SpanLike
+	span, err = spanClass().ParseSpan(source)
+	if err != nil {
+		return err
+	}
+	*v = span.(span_)
+	return nil
+}
+
+// Private Methods
+
+// This private class method returns the number of nanoseconds in the specified
+// number of milliseconds.
+func (c *spanClass_) nanosecondsPer(milliseconds uint) uint {
+	return milliseconds * uint(c.nanosecondsPerMillisecond_)
+}
+
+// This private class method returns the number of nanoseconds in the specified
+// span component (e.g. "1.5") with the specified units (in milliseconds).  The
+// whole and fractional parts are converted separately so that a whole number
+// of units is always exact.
+func (c *spanClass_) nanosecondsFromComponent(
+	component string,
+	milliseconds uint,
+) int {
+	var units = int(c.nanosecondsPer(milliseconds))
+	var whole, fraction, _ = sts.Cut(component, ".")
+	var integer, _ = stc.Atoi(whole)
+	var nanoseconds = integer * units
+	if len(fraction) > 0 {
+		var float, _ = stc.ParseFloat("0."+fraction, 64)
+		nanoseconds += int(mat.Round(float * float64(units)))
+	}
+	return nanoseconds
+}
+
+// This private class method determines whether or not the specified temporal
+// literal contains more than three digits of fractional seconds, requiring the
+// nanosecond precision of an instant or span rather than a moment or duration.
+// Only the seconds component of a duration literal is inspected since its other
+// components may also contain fractions.
+func (c *spanClass_) isPrecise(
+	source string,
+) bool {
+	var seconds = source
+	if sts.HasPrefix(source, "~") {
+		var matches = c.matcher_.FindStringSubmatch(source)
+		if len(matches) == 0 {
+			return false
+		}
+		seconds = matches[8]
+	}
+	var _, fraction, found = sts.Cut(seconds, ".")
+	if !found {
+		return false
+	}
+	var digits = sts.IndexFunc(fraction, func(r rune) bool {
+		return !uni.IsDigit(r)
+	})
+	if digits < 0 {
+		digits = len(fraction)
+	}
+	return digits > 3
+}
+
+func (c *spanClass_) spanFromMatches(matches []string) int {
+	var nanoseconds int
+	var units = []uint{
+		durationClass().millisecondsPerWeek_,
+		durationClass().millisecondsPerYear_,
+		durationClass().millisecondsPerMonth_,
+		durationClass().millisecondsPerDay_,
+		durationClass().millisecondsPerHour_,
+		durationClass().millisecondsPerMinute_,
+		durationClass().millisecondsPerSecond_,
+	}
+	for index, milliseconds := range units {
+		var component = matches[index+2]
+		if len(component) > 0 {
+			nanoseconds += c.nanosecondsFromComponent(component, milliseconds)
+		}
+	}
+	if matches[1] == "-" {
+		nanoseconds = -nanoseconds
+	}
+	return nanoseconds
+}
+
+func (v span_) magnitude() uint {
+	if v < 0 {
+		return uint(-v)
+	}
+	return uint(v)
+}
+
+// Instance Structure
+
+type span_ tim.Duration
+
+// Class Structure
+
+type spanClass_ struct {
+	// Declare the class constants.
+	matcher_                   *reg.Regexp
+	nanosecondsPerMicrosecond_ int
+	nanosecondsPerMillisecond_ int
+}
+
+// Class Reference
+
+func spanClass() *spanClass_ {
+	return spanClassReference_
+}
+
+var spanClassReference_ = &spanClass_{
+	// Initialize the class constants.
+	matcher_:                   durationClassReference_.matcher_, // The same literal format.
+	nanosecondsPerMicrosecond_: 1000,
+	nanosecondsPerMillisecond_: 1000000,
+}
@@ -13,7 +13,9 @@
 package elements
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
+	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
 	reg "regexp"
 )
@@ -48,6 +50,22 @@ func (c *symbolClass_) SymbolFromString(
 	return symbol_(matches[1]) // Strip off the leading "$".
 }
 
+func (c *symbolClass_) ParseSymbol(
+	source string,
+) (
+	symbol SymbolLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Symbol", c.matcher_)
+	var matches []string
+	matches, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	symbol = symbol_(matches[1]) // Strip off the leading "$".
+	return
+}
+
 // Constant Methods
 
 func (c *symbolClass_) Undefined() SymbolLike {
@@ -80,6 +98,27 @@ func (v symbol_) String() string {
 	return v.AsString()
 }
 
+func (v symbol_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *symbol_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var symbol SymbolLike
+	symbol, err = symbolClass().ParseSymbol(source)
+	if err != nil {
+		return err
+	}
+	*v = symbol.(symbol_)
+	return nil
+}
+
 // Private Methods
 
 // NOTE:
@@ -31,7 +31,9 @@ on interfaces, not on each other.
 package elements
 
 import (
+	big "math/big"
 	uri "net/url"
+	tim "time"
 )
 
 // TYPE DECLARATIONS
@@ -64,6 +66,12 @@ type AngleClassLike interface {
 	AngleFromString(
 		source string,
 	) AngleLike
+	ParseAngle(
+		source string,
+	) (
+		angle AngleLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() AngleLike
@@ -130,6 +138,12 @@ type BooleanClassLike interface {
 	BooleanFromString(
 		source string,
 	) BooleanLike
+	ParseBoolean(
+		source string,
+	) (
+		boolean BooleanLike,
+		err error,
+	)
 
 	// Constant Methods
 	False() BooleanLike
@@ -157,10 +171,143 @@ type BooleanClassLike interface {
 	) BooleanLike
 }
 
+/*
+DecimalClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+decimal-like concrete class.
+
+A decimal is an exact real number with an arbitrary number of digits.  Its
+literal uses the real number grammar (e.g. -1234.5678 or 6.02214076E23) but
+without the transcendental constants and infinities.  The canonical form of a
+decimal contains no exponent or trailing fractional zeros.
+
+The magnitude of a decimal must be less than 10^MaximumExponent() and it may
+have at most MaximumExponent() fractional digits, which is the exponent range of
+an IEEE 754 decimal128 value.  A literal outside this range results in a
+ParseError, and any other decimal outside this range (e.g. one constructed from
+parts or resulting from a function) is undefined.  This bounds the memory used
+by the canonical form of a decimal.
+
+The following class functions are supported:
+
+Inverse() and Reciprocal() return the additive and multiplicative inverses of a
+decimal.
+
+Sum(), Difference() and Product() return the exact sum, difference and product
+of two decimals.  Scaled() returns the product of a decimal and a factor.
+
+Quotient() returns the first decimal divided by the second decimal.  The result
+is exact when it has a terminating decimal expansion and is otherwise rounded to
+the number of significant digits returned by Precision().
+
+Remainder() returns the truncated remainder of the first decimal divided by the
+second decimal.
+
+Power() returns the base raised to an integral exponent.
+
+Any undefined argument, or a zero divisor, results in an undefined decimal.
+
+The Conjugate() and Logarithm() functions of the number class are deliberately
+omitted.  A decimal is always real, and the logarithm of a decimal is generally
+irrational so it has no exact decimal value.  The logarithm of a decimal can be
+calculated using the number returned by its AsNumber() method.
+*/
+type DecimalClassLike interface {
+	// Constructor Methods
+	Decimal(
+		unscaled *big.Int,
+		scale int,
+	) DecimalLike
+	DecimalFromInteger(
+		integer int,
+	) DecimalLike
+	DecimalFromFloat(
+		float float64,
+	) DecimalLike
+	DecimalFromNumber(
+		number NumberLike,
+	) DecimalLike
+	DecimalFromString(
+		source string,
+	) DecimalLike
+	ParseDecimal(
+		source string,
+	) (
+		decimal DecimalLike,
+		err error,
+	)
+
+	// Constant Methods
+	Undefined() DecimalLike
+	Zero() DecimalLike
+	One() DecimalLike
+	Precision() uint
+	MaximumExponent() uint
+
+	// Function Methods
+	Inverse(
+		decimal DecimalLike,
+	) DecimalLike
+	Reciprocal(
+		decimal DecimalLike,
+	) DecimalLike
+	Sum(
+		first DecimalLike,
+		second DecimalLike,
+	) DecimalLike
+	Difference(
+		first DecimalLike,
+		second DecimalLike,
+	) DecimalLike
+	Scaled(
+		decimal DecimalLike,
+		factor float64,
+	) DecimalLike
+	Product(
+		first DecimalLike,
+		second DecimalLike,
+	) DecimalLike
+	Quotient(
+		first DecimalLike,
+		second DecimalLike,
+	) DecimalLike
+	Remainder(
+		first DecimalLike,
+		second DecimalLike,
+	) DecimalLike
+	Power(
+		base DecimalLike,
+		exponent DecimalLike,
+	) DecimalLike
+}
+
 /*
 DurationClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
 duration-like concrete class.
+
+A duration may be negative, in which case its literal begins with "~-P" rather
+than "~P" (e.g. ~-P3DT4H).  The factored components of a duration are those of
+its magnitude.  The IsMinimum() method still reports whether a duration has the
+shortest magnitude (zero), so a negative duration is not a minimum duration.
+
+NOTE: Supporting negative durations is a breaking change.  The AsIntrinsic()
+method of a duration now returns a signed int rather than a uint, so callers
+that stored or compared its result as a uint must be updated.
+
+The following class functions are supported:
+
+Inverse() returns the duration with the opposite sign.
+
+Sum() and Difference() return the sum and difference of two durations.
+
+Scaled() returns the duration multiplied by a factor, rounded to the nearest
+millisecond.
+
+Ratio() returns the first duration divided by the second duration.
+
+Minimum() and Maximum() return the shorter and longer of two durations
+respectively, taking their signs into account.
 */
 type DurationClassLike interface {
 	// Constructor Methods
@@ -170,6 +317,12 @@ type DurationClassLike interface {
 	DurationFromString(
 		source string,
 	) DurationLike
+	ParseDuration(
+		source string,
+	) (
+		duration DurationLike,
+		err error,
+	)
 
 	// Constant Methods
 	MillisecondsPerSecond() uint
@@ -182,6 +335,54 @@ type DurationClassLike interface {
 	DaysPerMonth() float64
 	DaysPerYear() float64
 	WeeksPerMonth() float64
+
+	// Function Methods
+	Inverse(
+		duration DurationLike,
+	) DurationLike
+	Sum(
+		first DurationLike,
+		second DurationLike,
+	) DurationLike
+	Difference(
+		first DurationLike,
+		second DurationLike,
+	) DurationLike
+	Scaled(
+		duration DurationLike,
+		factor float64,
+	) DurationLike
+	Ratio(
+		first DurationLike,
+		second DurationLike,
+	) float64
+	Minimum(
+		first DurationLike,
+		second DurationLike,
+	) DurationLike
+	Maximum(
+		first DurationLike,
+		second DurationLike,
+	) DurationLike
+}
+
+/*
+ElementParserClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+element-parser-like concrete class.
+
+An element-parser-like class recognizes the element literal (e.g. "~π",
+"<2024-03-01>", "~P3D", "50%" or "$symbol") at the start of a source string
+and constructs the corresponding element using its class.  When more than one
+kind of literal matches the start of the source string the longest match wins.
+Moment and duration literals with more than three digits of fractional seconds
+result in an instant or span respectively so that no precision is lost, and a
+number literal followed by a slash and a denominator (e.g. "3/7") results in a
+rational.
+*/
+type ElementParserClassLike interface {
+	// Constructor Methods
+	ElementParser() ElementParserLike
 }
 
 /*
@@ -200,6 +401,12 @@ type GlyphClassLike interface {
 	GlyphFromString(
 		source string,
 	) GlyphLike
+	ParseGlyph(
+		source string,
+	) (
+		glyph GlyphLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() GlyphLike
@@ -213,10 +420,76 @@ type GlyphClassLike interface {
 	) GlyphLike
 }
 
+/*
+InstantClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+instant-like concrete class.
+
+An instant-like class is a nanosecond precision variant of a moment-like class.
+It uses the same literal format as a moment with up to nine digits of fractional
+seconds (e.g. <2024-03-01T12:00:00.123456789>), and converts to and from the Go
+time.Time type without any loss of precision.  Unlike the Duration() function of
+a moment-like class, the Span() function returns a signed span which is negative
+when the second instant is earlier than the first.
+*/
+type InstantClassLike interface {
+	// Constructor Methods
+	Instant(
+		seconds int,
+		nanoseconds int,
+	) InstantLike
+	InstantFromMoment(
+		moment MomentLike,
+	) InstantLike
+	InstantFromTime(
+		time tim.Time,
+	) InstantLike
+	InstantFromString(
+		source string,
+	) InstantLike
+	ParseInstant(
+		source string,
+	) (
+		instant InstantLike,
+		err error,
+	)
+
+	// Constant Methods
+	Epoch() InstantLike
+
+	// Function Methods
+	Now() InstantLike
+	Earlier(
+		instant InstantLike,
+		span SpanLike,
+	) InstantLike
+	Later(
+		instant InstantLike,
+		span SpanLike,
+	) InstantLike
+	Span(
+		first InstantLike,
+		second InstantLike,
+	) SpanLike
+}
+
 /*
 MomentClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
 moment-like concrete class.
+
+A moment literal may end with a time zone offset (e.g. <2024-03-01T12:00-05:00>
+or <2024-03-01T17:00Z>) as long as it includes the hour.  The offset is only
+used to determine the moment in UTC which is what gets stored.
+
+The following class functions are supported:
+
+CalendarLater() and CalendarEarlier() move a moment by a number of calendar
+years, months and days using the wall clock of the specified time zone.  Unlike
+Later() and Earlier() which move a moment by a fixed duration, they preserve the
+time of day across daylight saving time changes.  If the day of the month does
+not exist in the resulting month, the last day of that month is used instead
+(e.g. January 31st plus one month is the last day of February).
 */
 type MomentClassLike interface {
 	// Constructor Methods
@@ -226,6 +499,15 @@ type MomentClassLike interface {
 	MomentFromString(
 		source string,
 	) MomentLike
+	ParseMoment(
+		source string,
+	) (
+		moment MomentLike,
+		err error,
+	)
+	MomentFromTime(
+		time tim.Time,
+	) MomentLike
 
 	// Constant Methods
 	Epoch() MomentLike
@@ -244,6 +526,97 @@ type MomentClassLike interface {
 		first MomentLike,
 		second MomentLike,
 	) DurationLike
+	CalendarEarlier(
+		moment MomentLike,
+		years int,
+		months int,
+		days int,
+		zone *tim.Location,
+	) MomentLike
+	CalendarLater(
+		moment MomentLike,
+		years int,
+		months int,
+		days int,
+		zone *tim.Location,
+	) MomentLike
+}
+
+/*
+MoneyClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+money-like concrete class.
+
+An amount of money is held exactly as a whole number of the minor units (e.g.
+cents) of its ISO 4217 currency.  Its literal is the amount written with the
+number of decimal places used by the currency, followed by a space and the
+currency code (e.g. 12.34 USD or 1500 JPY).  An amount with fewer decimal places
+than the currency uses may also be parsed.
+
+The following class functions are supported:
+
+Inverse() returns the money with the opposite sign.
+
+Sum() and Difference() return the sum and difference of two amounts of money.
+They panic if the currencies of the amounts differ.
+
+Scaled() and Percentage() return the money multiplied by a factor or by a
+percentage, rounded to the nearest minor unit with ties rounded to the even
+minor unit.
+
+Allocate() divides the money into shares that are proportional to the specified
+ratios and Split() divides it into the specified number of equal shares.  The
+minor units that cannot be divided evenly are distributed one at a time to the
+leading shares so that the shares always add up to the original amount.
+*/
+type MoneyClassLike interface {
+	// Constructor Methods
+	Money(
+		currency string,
+		minorUnits int64,
+	) MoneyLike
+	MoneyFromDecimal(
+		currency string,
+		amount DecimalLike,
+	) MoneyLike
+	MoneyFromString(
+		source string,
+	) MoneyLike
+	ParseMoney(
+		source string,
+	) (
+		money MoneyLike,
+		err error,
+	)
+
+	// Function Methods
+	Inverse(
+		money MoneyLike,
+	) MoneyLike
+	Sum(
+		first MoneyLike,
+		second MoneyLike,
+	) MoneyLike
+	Difference(
+		first MoneyLike,
+		second MoneyLike,
+	) MoneyLike
+	Scaled(
+		money MoneyLike,
+		factor float64,
+	) MoneyLike
+	Percentage(
+		money MoneyLike,
+		percentage PercentageLike,
+	) MoneyLike
+	Allocate(
+		money MoneyLike,
+		ratios []uint,
+	) []MoneyLike
+	Split(
+		money MoneyLike,
+		parts uint,
+	) []MoneyLike
 }
 
 /*
@@ -273,6 +646,12 @@ type NumberClassLike interface {
 	NumberFromString(
 		source string,
 	) NumberLike
+	ParseNumber(
+		source string,
+	) (
+		number NumberLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() NumberLike
@@ -347,6 +726,12 @@ type PercentageClassLike interface {
 	PercentageFromString(
 		source string,
 	) PercentageLike
+	ParsePercentage(
+		source string,
+	) (
+		percentage PercentageLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() PercentageLike
@@ -368,6 +753,12 @@ type ProbabilityClassLike interface {
 	ProbabilityFromString(
 		source string,
 	) ProbabilityLike
+	ParseProbability(
+		source string,
+	) (
+		probability ProbabilityLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() ProbabilityLike
@@ -395,6 +786,171 @@ type ProbabilityClassLike interface {
 	) ProbabilityLike
 }
 
+/*
+QuantityClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+quantity-like concrete class.
+
+A quantity pairs a number with a unit expression.  Its literal is the real
+number followed by a space and the unit expression (e.g. 9.81 m/s^2).  A unit
+expression is a sequence of unit factors, each a (possibly prefixed) SI unit
+symbol with an optional integer exponent (e.g. km, kg or s^-1).  The factors
+are separated by "·" or "*" to multiply them, or by "/" to divide by the next
+factor.  The SI base and derived units, along with the min, h, d, L, t and eV
+units that are accepted for use with them, are supported.  A quantity without
+a unit expression is dimensionless.
+
+The following class functions are supported:
+
+Inverse() returns the quantity with the opposite sign.
+
+Sum() and Difference() return the sum and difference of two quantities in the
+units of the first quantity.  They panic if the dimensions of the quantities
+differ.
+
+Scaled() returns the quantity multiplied by a factor.
+
+Product() and Quotient() return the product and quotient of two quantities with
+their unit expressions combined (e.g. 10 m divided by 2 s is 5 m/s).
+*/
+type QuantityClassLike interface {
+	// Constructor Methods
+	Quantity(
+		magnitude NumberLike,
+		units string,
+	) QuantityLike
+	QuantityFromString(
+		source string,
+	) QuantityLike
+	ParseQuantity(
+		source string,
+	) (
+		quantity QuantityLike,
+		err error,
+	)
+
+	// Function Methods
+	Inverse(
+		quantity QuantityLike,
+	) QuantityLike
+	Sum(
+		first QuantityLike,
+		second QuantityLike,
+	) QuantityLike
+	Difference(
+		first QuantityLike,
+		second QuantityLike,
+	) QuantityLike
+	Scaled(
+		quantity QuantityLike,
+		factor float64,
+	) QuantityLike
+	Product(
+		first QuantityLike,
+		second QuantityLike,
+	) QuantityLike
+	Quotient(
+		first QuantityLike,
+		second QuantityLike,
+	) QuantityLike
+}
+
+/*
+RationalClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+rational-like concrete class.
+
+A rational is an exact fraction with an arbitrarily large numerator and a
+non-zero denominator.  Its literal is the numerator and denominator separated
+by a slash (e.g. 3/7 or -22/7).  The canonical form of a rational is reduced to
+its lowest terms and always includes the denominator (e.g. 6/3 becomes 2/1).
+
+The following class functions are supported:
+
+Inverse() and Reciprocal() return the additive and multiplicative inverses of a
+rational.
+
+Sum(), Difference(), Product() and Quotient() return the exact sum, difference,
+product and quotient of two rationals.  Scaled() returns the product of a
+rational and a factor.
+
+Remainder() returns the truncated remainder of the first rational divided by
+the second rational.
+
+Power() returns the base raised to an integral exponent.
+
+Any undefined argument, or a zero divisor, results in an undefined rational.
+*/
+type RationalClassLike interface {
+	// Constructor Methods
+	Rational(
+		rational *big.Rat,
+	) RationalLike
+	RationalFromFraction(
+		numerator int,
+		denominator int,
+	) RationalLike
+	RationalFromInteger(
+		integer int,
+	) RationalLike
+	RationalFromFloat(
+		float float64,
+	) RationalLike
+	RationalFromDecimal(
+		decimal DecimalLike,
+	) RationalLike
+	RationalFromString(
+		source string,
+	) RationalLike
+	ParseRational(
+		source string,
+	) (
+		rational RationalLike,
+		err error,
+	)
+
+	// Constant Methods
+	Undefined() RationalLike
+	Zero() RationalLike
+	One() RationalLike
+
+	// Function Methods
+	Inverse(
+		rational RationalLike,
+	) RationalLike
+	Reciprocal(
+		rational RationalLike,
+	) RationalLike
+	Sum(
+		first RationalLike,
+		second RationalLike,
+	) RationalLike
+	Difference(
+		first RationalLike,
+		second RationalLike,
+	) RationalLike
+	Scaled(
+		rational RationalLike,
+		factor float64,
+	) RationalLike
+	Product(
+		first RationalLike,
+		second RationalLike,
+	) RationalLike
+	Quotient(
+		first RationalLike,
+		second RationalLike,
+	) RationalLike
+	Remainder(
+		first RationalLike,
+		second RationalLike,
+	) RationalLike
+	Power(
+		base RationalLike,
+		exponent RationalLike,
+	) RationalLike
+}
+
 /*
 ResourceClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -408,6 +964,12 @@ type ResourceClassLike interface {
 	ResourceFromString(
 		source string,
 	) ResourceLike
+	ParseResource(
+		source string,
+	) (
+		resource ResourceLike,
+		err error,
+	)
 	ResourceFromUri(
 		url *uri.URL,
 	) ResourceLike
@@ -416,6 +978,40 @@ type ResourceClassLike interface {
 	Undefined() ResourceLike
 }
 
+/*
+SpanClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+span-like concrete class.
+
+A span-like class is a signed nanosecond precision variant of a duration-like
+class.  It uses the same literal format as a duration with up to nine digits of
+fractional seconds and an optional minus sign (e.g. ~PT0.000001S or ~-P3DT4H),
+and converts to and from the Go time.Duration type without any loss of
+precision.
+*/
+type SpanClassLike interface {
+	// Constructor Methods
+	Span(
+		duration tim.Duration,
+	) SpanLike
+	SpanFromDuration(
+		duration DurationLike,
+	) SpanLike
+	SpanFromString(
+		source string,
+	) SpanLike
+	ParseSpan(
+		source string,
+	) (
+		span SpanLike,
+		err error,
+	)
+
+	// Constant Methods
+	NanosecondsPerMicrosecond() int
+	NanosecondsPerMillisecond() int
+}
+
 /*
 SymbolClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -429,6 +1025,12 @@ type SymbolClassLike interface {
 	SymbolFromString(
 		source string,
 	) SymbolLike
+	ParseSymbol(
+		source string,
+	) (
+		symbol SymbolLike,
+		err error,
+	)
 
 	// Constant Methods
 	Undefined() SymbolLike
@@ -473,6 +1075,26 @@ type BooleanLike interface {
 	Discrete
 }
 
+/*
+DecimalLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a decimal-like class.  The value of a decimal is its unscaled
+integer multiplied by ten to the power of its negated scale.
+*/
+type DecimalLike interface {
+	// Principal Methods
+	GetClass() DecimalClassLike
+	AsIntrinsic() *big.Rat
+	AsNumber() NumberLike
+	AsString() string
+	GetUnscaled() *big.Int
+	GetScale() int
+
+	// Aspect Interfaces
+	Continuous
+	Polarized
+}
+
 /*
 DurationLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
@@ -481,15 +1103,38 @@ instance of a duration-like class.
 type DurationLike interface {
 	// Principal Methods
 	GetClass() DurationClassLike
-	AsIntrinsic() uint
+	AsIntrinsic() int
 	AsString() string
 
 	// Aspect Interfaces
 	Discrete
 	Factored
+	Polarized
 	Temporal
 }
 
+/*
+ElementParserLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of an element-parser-like class.
+
+The ParseElement() method returns the element whose literal begins at the
+start of the specified source string along with the number of bytes in that
+literal.  If the source string does not begin with an element literal, or the
+literal does not describe a legal element (e.g. "<2024-02-30>"), the element is
+nil and the length is zero.
+*/
+type ElementParserLike interface {
+	// Principal Methods
+	GetClass() ElementParserClassLike
+	ParseElement(
+		source string,
+	) (
+		element any,
+		length uint,
+	)
+}
+
 /*
 GlyphLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
@@ -505,16 +1150,52 @@ type GlyphLike interface {
 	Discrete
 }
 
+/*
+InstantLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of an instant-like class.
+
+The GetMicroseconds() and GetNanoseconds() methods return the microseconds
+within the current millisecond and the nanoseconds within the current
+microsecond respectively.
+*/
+type InstantLike interface {
+	// Principal Methods
+	GetClass() InstantClassLike
+	AsMoment() MomentLike
+	AsString() string
+	AsTime() tim.Time
+	AsZonedString(
+		zone *tim.Location,
+	) string
+	GetMicroseconds() uint
+	GetNanoseconds() uint
+
+	// Aspect Interfaces
+	Factored
+	Polarized
+	Temporal
+}
+
 /*
 MomentLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
 instance of a moment-like class.
+
+The AsString() method returns the moment in UTC while the AsZonedString() method
+returns it using the wall clock and offset of the specified time zone.  Named
+IANA time zones (e.g. "America/New_York") are available to time.LoadLocation()
+on all platforms since the time zone database is embedded in this package.
 */
 type MomentLike interface {
 	// Principal Methods
 	GetClass() MomentClassLike
 	AsIntrinsic() int
 	AsString() string
+	AsTime() tim.Time
+	AsZonedString(
+		zone *tim.Location,
+	) string
 
 	// Aspect Interfaces
 	Discrete
@@ -523,6 +1204,25 @@ type MomentLike interface {
 	Temporal
 }
 
+/*
+MoneyLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a money-like class.  The AsDecimal() method returns the amount of
+money in the major units of its currency.
+*/
+type MoneyLike interface {
+	// Principal Methods
+	GetClass() MoneyClassLike
+	AsString() string
+	AsDecimal() DecimalLike
+	GetCurrency() string
+	GetMinorUnits() int64
+	IsZero() bool
+
+	// Aspect Interfaces
+	Polarized
+}
+
 /*
 NumberLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
@@ -576,6 +1276,52 @@ type ProbabilityLike interface {
 	Continuous
 }
 
+/*
+QuantityLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a quantity-like class.  The AsUnits() method converts the quantity
+into the specified units with the same dimensions, and the GetDimensions()
+method returns its dimensions as a unit expression containing only SI base
+units (e.g. m·kg/s^2 for a newton).  The float value of a quantity is expressed
+in those SI base units so that quantities with the same dimensions are ordered
+correctly.
+*/
+type QuantityLike interface {
+	// Principal Methods
+	GetClass() QuantityClassLike
+	AsUnits(
+		units string,
+	) QuantityLike
+	AsString() string
+	GetMagnitude() NumberLike
+	GetUnits() string
+	GetDimensions() string
+
+	// Aspect Interfaces
+	Continuous
+	Polarized
+}
+
+/*
+RationalLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a rational-like class.
+*/
+type RationalLike interface {
+	// Principal Methods
+	GetClass() RationalClassLike
+	AsIntrinsic() *big.Rat
+	AsDecimal() DecimalLike
+	AsNumber() NumberLike
+	AsString() string
+	GetNumerator() *big.Int
+	GetDenominator() *big.Int
+
+	// Aspect Interfaces
+	Continuous
+	Polarized
+}
+
 /*
 ResourceLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
@@ -594,6 +1340,29 @@ type ResourceLike interface {
 	GetFragment() string
 }
 
+/*
+SpanLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a span-like class.
+
+The AsDuration() method truncates the span to whole milliseconds.  The factored
+components of a span are those of its magnitude.
+*/
+type SpanLike interface {
+	// Principal Methods
+	GetClass() SpanClassLike
+	AsIntrinsic() tim.Duration
+	AsDuration() DurationLike
+	GetMicroseconds() uint
+	GetNanoseconds() uint
+
+	// Aspect Interfaces
+	Discrete
+	Factored
+	Polarized
+	Temporal
+}
+
 /*
 SymbolLike is an instance interface that declares the complete set of principal,
 attribute and aspect methods that must be supported by each instance of a
@@ -31,12 +31,18 @@ For detailed documentation on this entire module refer to the wiki:
 package module
 
 import (
+	ctx "context"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	col "github.com/craterdog/go-component-framework/v7/collections"
 	ele "github.com/craterdog/go-component-framework/v7/elements"
 	ran "github.com/craterdog/go-component-framework/v7/ranges"
 	str "github.com/craterdog/go-component-framework/v7/strings"
+	big "math/big"
 	uri "net/url"
+	reg "regexp"
+	sts "strings"
+	tim "time"
+	uni "unicode"
 )
 
 // TYPE ALIASES
@@ -45,8 +51,10 @@ import (
 
 type (
 	Event       = age.Event
+	ParseError  = age.ParseError
 	Rank        = age.Rank
 	State       = age.State
+	Tagged      = age.Tagged
 	Transitions = age.Transitions
 )
 
@@ -66,6 +74,7 @@ type (
 	EncoderClassLike         = age.EncoderClassLike
 	GeneratorClassLike       = age.GeneratorClassLike
 	IteratorClassLike[V any] = age.IteratorClassLike[V]
+	MatcherClassLike         = age.MatcherClassLike
 	SorterClassLike[V any]   = age.SorterClassLike[V]
 )
 
@@ -75,35 +84,82 @@ type (
 	EncoderLike         = age.EncoderLike
 	GeneratorLike       = age.GeneratorLike
 	IteratorLike[V any] = age.IteratorLike[V]
+	MatcherLike         = age.MatcherLike
 	SorterLike[V any]   = age.SorterLike[V]
 )
 
 // Collections
 
 type (
-	AssociationClassLike[K comparable, V any] = col.AssociationClassLike[K, V]
-	CatalogClassLike[K comparable, V any]     = col.CatalogClassLike[K, V]
-	ListClassLike[V any]                      = col.ListClassLike[V]
-	QueueClassLike[V any]                     = col.QueueClassLike[V]
-	SetClassLike[V any]                       = col.SetClassLike[V]
-	StackClassLike[V any]                     = col.StackClassLike[V]
+	Status = col.Status
+)
+
+const (
+	Succeeded = col.Succeeded
+	Blocked   = col.Blocked
+	TimedOut  = col.TimedOut
+	Cancelled = col.Cancelled
+	Closed    = col.Closed
+)
+
+type (
+	MappingFunction[V any]   = col.MappingFunction[V]
+	PredicateFunction[V any] = col.PredicateFunction[V]
+)
+
+type (
+	AssociationClassLike[K any, V any]              = col.AssociationClassLike[K, V]
+	BagClassLike[V any]                             = col.BagClassLike[V]
+	BatcherClassLike[V any]                         = col.BatcherClassLike[V]
+	CatalogClassLike[K comparable, V any]           = col.CatalogClassLike[K, V]
+	CodecClassLike                                  = col.CodecClassLike
+	CollectionParserClassLike                       = col.CollectionParserClassLike
+	DequeClassLike[V any]                           = col.DequeClassLike[V]
+	DictionaryClassLike[K any, V any]               = col.DictionaryClassLike[K, V]
+	GraphClassLike[V comparable]                    = col.GraphClassLike[V]
+	GroupClassLike                                  = col.GroupClassLike
+	HierarchyClassLike[V any]                       = col.HierarchyClassLike[V]
+	ListClassLike[V any]                            = col.ListClassLike[V]
+	MultiCatalogClassLike[K comparable, V any]      = col.MultiCatalogClassLike[K, V]
+	PersistentCatalogClassLike[K comparable, V any] = col.PersistentCatalogClassLike[K, V]
+	PersistentListClassLike[V any]                  = col.PersistentListClassLike[V]
+	PersistentSetClassLike[V any]                   = col.PersistentSetClassLike[V]
+	PriorityQueueClassLike[V any]                   = col.PriorityQueueClassLike[V]
+	QueueClassLike[V any]                           = col.QueueClassLike[V]
+	SetClassLike[V any]                             = col.SetClassLike[V]
+	StackClassLike[V any]                           = col.StackClassLike[V]
 )
 
 type (
-	AssociationLike[K comparable, V any] = col.AssociationLike[K, V]
-	CatalogLike[K comparable, V any]     = col.CatalogLike[K, V]
-	ListLike[V any]                      = col.ListLike[V]
-	QueueLike[V any]                     = col.QueueLike[V]
-	SetLike[V any]                       = col.SetLike[V]
-	StackLike[V any]                     = col.StackLike[V]
+	AssociationLike[K any, V any]              = col.AssociationLike[K, V]
+	BagLike[V any]                             = col.BagLike[V]
+	CatalogLike[K comparable, V any]           = col.CatalogLike[K, V]
+	CodecLike                                  = col.CodecLike
+	CollectionParserLike                       = col.CollectionParserLike
+	DequeLike[V any]                           = col.DequeLike[V]
+	DictionaryLike[K any, V any]               = col.DictionaryLike[K, V]
+	GraphLike[V comparable]                    = col.GraphLike[V]
+	GroupLike                                  = col.GroupLike
+	HierarchyLike[V any]                       = col.HierarchyLike[V]
+	ListLike[V any]                            = col.ListLike[V]
+	MultiCatalogLike[K comparable, V any]      = col.MultiCatalogLike[K, V]
+	PersistentCatalogLike[K comparable, V any] = col.PersistentCatalogLike[K, V]
+	PersistentListLike[V any]                  = col.PersistentListLike[V]
+	PersistentSetLike[V any]                   = col.PersistentSetLike[V]
+	PriorityQueueLike[V any]                   = col.PriorityQueueLike[V]
+	QueueLike[V any]                           = col.QueueLike[V]
+	SetLike[V any]                             = col.SetLike[V]
+	StackLike[V any]                           = col.StackLike[V]
 )
 
 type (
 	Associative[K comparable, V any] = col.Associative[K, V]
+	Closable                         = col.Closable
 	Elastic[V any]                   = col.Elastic[V]
 	Fifo[V any]                      = col.Fifo[V]
 	Lifo[V any]                      = col.Lifo[V]
 	Malleable[V any]                 = col.Malleable[V]
+	Ranged[V any]                    = col.Ranged[V]
 	Sortable[V any]                  = col.Sortable[V]
 	Synchronized                     = col.Synchronized
 	Updatable[V any]                 = col.Updatable[V]
@@ -122,29 +178,43 @@ const (
 )
 
 type (
-	AngleClassLike       = ele.AngleClassLike
-	BooleanClassLike     = ele.BooleanClassLike
-	DurationClassLike    = ele.DurationClassLike
-	GlyphClassLike       = ele.GlyphClassLike
-	MomentClassLike      = ele.MomentClassLike
-	NumberClassLike      = ele.NumberClassLike
-	PercentageClassLike  = ele.PercentageClassLike
-	ProbabilityClassLike = ele.ProbabilityClassLike
-	ResourceClassLike    = ele.ResourceClassLike
-	SymbolClassLike      = ele.SymbolClassLike
+	AngleClassLike         = ele.AngleClassLike
+	BooleanClassLike       = ele.BooleanClassLike
+	DecimalClassLike       = ele.DecimalClassLike
+	DurationClassLike      = ele.DurationClassLike
+	ElementParserClassLike = ele.ElementParserClassLike
+	GlyphClassLike         = ele.GlyphClassLike
+	InstantClassLike       = ele.InstantClassLike
+	MomentClassLike        = ele.MomentClassLike
+	MoneyClassLike         = ele.MoneyClassLike
+	NumberClassLike        = ele.NumberClassLike
+	PercentageClassLike    = ele.PercentageClassLike
+	ProbabilityClassLike   = ele.ProbabilityClassLike
+	QuantityClassLike      = ele.QuantityClassLike
+	RationalClassLike      = ele.RationalClassLike
+	ResourceClassLike      = ele.ResourceClassLike
+	SpanClassLike          = ele.SpanClassLike
+	SymbolClassLike        = ele.SymbolClassLike
 )
 
 type (
-	AngleLike       = ele.AngleLike
-	BooleanLike     = ele.BooleanLike
-	DurationLike    = ele.DurationLike
-	GlyphLike       = ele.GlyphLike
-	MomentLike      = ele.MomentLike
-	NumberLike      = ele.NumberLike
-	PercentageLike  = ele.PercentageLike
-	ProbabilityLike = ele.ProbabilityLike
-	ResourceLike    = ele.ResourceLike
-	SymbolLike      = ele.SymbolLike
+	AngleLike         = ele.AngleLike
+	BooleanLike       = ele.BooleanLike
+	DecimalLike       = ele.DecimalLike
+	DurationLike      = ele.DurationLike
+	ElementParserLike = ele.ElementParserLike
+	GlyphLike         = ele.GlyphLike
+	InstantLike       = ele.InstantLike
+	MomentLike        = ele.MomentLike
+	MoneyLike         = ele.MoneyLike
+	NumberLike        = ele.NumberLike
+	PercentageLike    = ele.PercentageLike
+	ProbabilityLike   = ele.ProbabilityLike
+	QuantityLike      = ele.QuantityLike
+	RationalLike      = ele.RationalLike
+	ResourceLike      = ele.ResourceLike
+	SpanLike          = ele.SpanLike
+	SymbolLike        = ele.SymbolLike
 )
 
 type (
@@ -191,23 +261,25 @@ type (
 )
 
 type (
-	BinaryClassLike    = str.BinaryClassLike
-	NameClassLike      = str.NameClassLike
-	NarrativeClassLike = str.NarrativeClassLike
-	PatternClassLike   = str.PatternClassLike
-	QuoteClassLike     = str.QuoteClassLike
-	TagClassLike       = str.TagClassLike
-	VersionClassLike   = str.VersionClassLike
+	BinaryClassLike       = str.BinaryClassLike
+	NameClassLike         = str.NameClassLike
+	NarrativeClassLike    = str.NarrativeClassLike
+	PatternClassLike      = str.PatternClassLike
+	QuoteClassLike        = str.QuoteClassLike
+	StringParserClassLike = str.StringParserClassLike
+	TagClassLike          = str.TagClassLike
+	VersionClassLike      = str.VersionClassLike
 )
 
 type (
-	BinaryLike    = str.BinaryLike
-	NameLike      = str.NameLike
-	NarrativeLike = str.NarrativeLike
-	PatternLike   = str.PatternLike
-	QuoteLike     = str.QuoteLike
-	TagLike       = str.TagLike
-	VersionLike   = str.VersionLike
+	BinaryLike       = str.BinaryLike
+	NameLike         = str.NameLike
+	NarrativeLike    = str.NarrativeLike
+	PatternLike      = str.PatternLike
+	QuoteLike        = str.QuoteLike
+	StringParserLike = str.StringParserLike
+	TagLike          = str.TagLike
+	VersionLike      = str.VersionLike
 )
 
 type (
@@ -281,6 +353,20 @@ func Iterator[V any](
 	)
 }
 
+func MatcherClass() MatcherClassLike {
+	return age.MatcherClass()
+}
+
+func Matcher(
+	class string,
+	expression *reg.Regexp,
+) MatcherLike {
+	return MatcherClass().Matcher(
+		class,
+		expression,
+	)
+}
+
 func SorterClass[V any]() SorterClassLike[V] {
 	return age.SorterClass[V]()
 }
@@ -299,11 +385,11 @@ func SorterWithRanker[V any](
 
 // Collections
 
-func AssociationClass[K comparable, V any]() AssociationClassLike[K, V] {
+func AssociationClass[K any, V any]() AssociationClassLike[K, V] {
 	return col.AssociationClass[K, V]()
 }
 
-func Association[K comparable, V any](
+func Association[K any, V any](
 	key K,
 	value V,
 ) AssociationLike[K, V] {
@@ -313,6 +399,50 @@ func Association[K comparable, V any](
 	)
 }
 
+func BagClass[V any]() BagClassLike[V] {
+	return col.BagClass[V]()
+}
+
+func Bag[V any]() BagLike[V] {
+	return BagClass[V]().Bag()
+}
+
+func BagWithCollator[V any](
+	collator age.CollatorLike[V],
+) BagLike[V] {
+	return BagClass[V]().BagWithCollator(
+		collator,
+	)
+}
+
+func BagFromArray[V any](
+	values []V,
+) BagLike[V] {
+	return BagClass[V]().BagFromArray(
+		values,
+	)
+}
+
+func BagFromSequence[V any](
+	values str.Sequential[V],
+) BagLike[V] {
+	return BagClass[V]().BagFromSequence(
+		values,
+	)
+}
+
+func BagFromString[V any](
+	source string,
+) BagLike[V] {
+	return BagClass[V]().BagFromString(
+		source,
+	)
+}
+
+func BatcherClass[V any]() BatcherClassLike[V] {
+	return col.BatcherClass[V]()
+}
+
 func CatalogClass[K comparable, V any]() CatalogClassLike[K, V] {
 	return col.CatalogClass[K, V]()
 }
@@ -345,6 +475,174 @@ func CatalogFromSequence[K comparable, V any](
 	)
 }
 
+func CatalogFromString[K comparable, V any](
+	source string,
+) CatalogLike[K, V] {
+	return CatalogClass[K, V]().CatalogFromString(
+		source,
+	)
+}
+
+func CodecClass() CodecClassLike {
+	return col.CodecClass()
+}
+
+func Codec() CodecLike {
+	return CodecClass().Codec()
+}
+
+func CollectionParserClass() CollectionParserClassLike {
+	return col.CollectionParserClass()
+}
+
+func CollectionParser() CollectionParserLike {
+	return CollectionParserClass().CollectionParser()
+}
+
+func DequeClass[V any]() DequeClassLike[V] {
+	return col.DequeClass[V]()
+}
+
+func Deque[V any]() DequeLike[V] {
+	return DequeClass[V]().Deque()
+}
+
+func BlockingDeque[V any](
+	capacity uint,
+) DequeLike[V] {
+	return DequeClass[V]().BlockingDeque(
+		capacity,
+	)
+}
+
+func DequeFromArray[V any](
+	values []V,
+) DequeLike[V] {
+	return DequeClass[V]().DequeFromArray(
+		values,
+	)
+}
+
+func DequeFromSequence[V any](
+	values str.Sequential[V],
+) DequeLike[V] {
+	return DequeClass[V]().DequeFromSequence(
+		values,
+	)
+}
+
+func DequeFromString[V any](
+	source string,
+) DequeLike[V] {
+	return DequeClass[V]().DequeFromString(
+		source,
+	)
+}
+
+func DictionaryClass[K any, V any]() DictionaryClassLike[K, V] {
+	return col.DictionaryClass[K, V]()
+}
+
+func Dictionary[K any, V any]() DictionaryLike[K, V] {
+	return DictionaryClass[K, V]().Dictionary()
+}
+
+func DictionaryWithCollator[K any, V any](
+	collator age.CollatorLike[K],
+) DictionaryLike[K, V] {
+	return DictionaryClass[K, V]().DictionaryWithCollator(
+		collator,
+	)
+}
+
+func DictionaryFromArray[K any, V any](
+	associations []col.AssociationLike[K, V],
+) DictionaryLike[K, V] {
+	return DictionaryClass[K, V]().DictionaryFromArray(
+		associations,
+	)
+}
+
+func DictionaryFromSequence[K any, V any](
+	associations str.Sequential[col.AssociationLike[K, V]],
+) DictionaryLike[K, V] {
+	return DictionaryClass[K, V]().DictionaryFromSequence(
+		associations,
+	)
+}
+
+func DictionaryFromString[K any, V any](
+	source string,
+) DictionaryLike[K, V] {
+	return DictionaryClass[K, V]().DictionaryFromString(
+		source,
+	)
+}
+
+func GraphClass[V comparable]() GraphClassLike[V] {
+	return col.GraphClass[V]()
+}
+
+func Graph[V comparable]() GraphLike[V] {
+	return GraphClass[V]().Graph()
+}
+
+func GraphFromCatalog[V comparable](
+	adjacencies col.CatalogLike[V, col.ListLike[V]],
+) GraphLike[V] {
+	return GraphClass[V]().GraphFromCatalog(
+		adjacencies,
+	)
+}
+
+func GroupClass() GroupClassLike {
+	return col.GroupClass()
+}
+
+func Group() GroupLike {
+	return GroupClass().Group()
+}
+
+func GroupWithContext(
+	context ctx.Context,
+) GroupLike {
+	return GroupClass().GroupWithContext(
+		context,
+	)
+}
+
+func HierarchyClass[V any]() HierarchyClassLike[V] {
+	return col.HierarchyClass[V]()
+}
+
+func Hierarchy[V any]() HierarchyLike[V] {
+	return HierarchyClass[V]().Hierarchy()
+}
+
+func HierarchyFromArray[V any](
+	associations []col.AssociationLike[str.NameLike, V],
+) HierarchyLike[V] {
+	return HierarchyClass[V]().HierarchyFromArray(
+		associations,
+	)
+}
+
+func HierarchyFromSequence[V any](
+	associations str.Sequential[col.AssociationLike[str.NameLike, V]],
+) HierarchyLike[V] {
+	return HierarchyClass[V]().HierarchyFromSequence(
+		associations,
+	)
+}
+
+func HierarchyFromString[V any](
+	source string,
+) HierarchyLike[V] {
+	return HierarchyClass[V]().HierarchyFromString(
+		source,
+	)
+}
+
 func ListClass[V any]() ListClassLike[V] {
 	return col.ListClass[V]()
 }
@@ -369,6 +667,208 @@ func ListFromSequence[V any](
 	)
 }
 
+func ListFromString[V any](
+	source string,
+) ListLike[V] {
+	return ListClass[V]().ListFromString(
+		source,
+	)
+}
+
+func MultiCatalogClass[K comparable, V any]() MultiCatalogClassLike[K, V] {
+	return col.MultiCatalogClass[K, V]()
+}
+
+func MultiCatalog[K comparable, V any]() MultiCatalogLike[K, V] {
+	return MultiCatalogClass[K, V]().MultiCatalog()
+}
+
+func MultiCatalogFromArray[K comparable, V any](
+	mappings []col.AssociationLike[K, V],
+) MultiCatalogLike[K, V] {
+	return MultiCatalogClass[K, V]().MultiCatalogFromArray(
+		mappings,
+	)
+}
+
+func MultiCatalogFromSequence[K comparable, V any](
+	mappings str.Sequential[col.AssociationLike[K, V]],
+) MultiCatalogLike[K, V] {
+	return MultiCatalogClass[K, V]().MultiCatalogFromSequence(
+		mappings,
+	)
+}
+
+func MultiCatalogFromString[K comparable, V any](
+	source string,
+) MultiCatalogLike[K, V] {
+	return MultiCatalogClass[K, V]().MultiCatalogFromString(
+		source,
+	)
+}
+
+func PersistentCatalogClass[K comparable, V any]() PersistentCatalogClassLike[K, V] {
+	return col.PersistentCatalogClass[K, V]()
+}
+
+func PersistentCatalog[K comparable, V any]() PersistentCatalogLike[K, V] {
+	return PersistentCatalogClass[K, V]().PersistentCatalog()
+}
+
+func PersistentCatalogFromArray[K comparable, V any](
+	associations []col.AssociationLike[K, V],
+) PersistentCatalogLike[K, V] {
+	return PersistentCatalogClass[K, V]().PersistentCatalogFromArray(
+		associations,
+	)
+}
+
+func PersistentCatalogFromMap[K comparable, V any](
+	associations map[K]V,
+) PersistentCatalogLike[K, V] {
+	return PersistentCatalogClass[K, V]().PersistentCatalogFromMap(
+		associations,
+	)
+}
+
+func PersistentCatalogFromSequence[K comparable, V any](
+	associations str.Sequential[col.AssociationLike[K, V]],
+) PersistentCatalogLike[K, V] {
+	return PersistentCatalogClass[K, V]().PersistentCatalogFromSequence(
+		associations,
+	)
+}
+
+func PersistentCatalogFromString[K comparable, V any](
+	source string,
+) PersistentCatalogLike[K, V] {
+	return PersistentCatalogClass[K, V]().PersistentCatalogFromString(
+		source,
+	)
+}
+
+func PersistentListClass[V any]() PersistentListClassLike[V] {
+	return col.PersistentListClass[V]()
+}
+
+func PersistentList[V any]() PersistentListLike[V] {
+	return PersistentListClass[V]().PersistentList()
+}
+
+func PersistentListFromArray[V any](
+	values []V,
+) PersistentListLike[V] {
+	return PersistentListClass[V]().PersistentListFromArray(
+		values,
+	)
+}
+
+func PersistentListFromSequence[V any](
+	values str.Sequential[V],
+) PersistentListLike[V] {
+	return PersistentListClass[V]().PersistentListFromSequence(
+		values,
+	)
+}
+
+func PersistentListFromString[V any](
+	source string,
+) PersistentListLike[V] {
+	return PersistentListClass[V]().PersistentListFromString(
+		source,
+	)
+}
+
+func PersistentSetClass[V any]() PersistentSetClassLike[V] {
+	return col.PersistentSetClass[V]()
+}
+
+func PersistentSet[V any]() PersistentSetLike[V] {
+	return PersistentSetClass[V]().PersistentSet()
+}
+
+func PersistentSetWithCollator[V any](
+	collator age.CollatorLike[V],
+) PersistentSetLike[V] {
+	return PersistentSetClass[V]().PersistentSetWithCollator(
+		collator,
+	)
+}
+
+func PersistentSetFromArray[V any](
+	values []V,
+) PersistentSetLike[V] {
+	return PersistentSetClass[V]().PersistentSetFromArray(
+		values,
+	)
+}
+
+func PersistentSetFromSequence[V any](
+	values str.Sequential[V],
+) PersistentSetLike[V] {
+	return PersistentSetClass[V]().PersistentSetFromSequence(
+		values,
+	)
+}
+
+func PersistentSetFromString[V any](
+	source string,
+) PersistentSetLike[V] {
+	return PersistentSetClass[V]().PersistentSetFromString(
+		source,
+	)
+}
+
+func PriorityQueueClass[V any]() PriorityQueueClassLike[V] {
+	return col.PriorityQueueClass[V]()
+}
+
+func PriorityQueue[V any]() PriorityQueueLike[V] {
+	return PriorityQueueClass[V]().PriorityQueue()
+}
+
+func PriorityQueueWithRanker[V any](
+	ranker age.RankingFunction[V],
+) PriorityQueueLike[V] {
+	return PriorityQueueClass[V]().PriorityQueueWithRanker(
+		ranker,
+	)
+}
+
+func BlockingPriorityQueue[V any](
+	ranker age.RankingFunction[V],
+	capacity uint,
+) PriorityQueueLike[V] {
+	return PriorityQueueClass[V]().BlockingPriorityQueue(
+		ranker,
+		capacity,
+	)
+}
+
+func PriorityQueueFromArray[V any](
+	values []V,
+) PriorityQueueLike[V] {
+	return PriorityQueueClass[V]().PriorityQueueFromArray(
+		values,
+	)
+}
+
+func PriorityQueueFromSequence[V any](
+	values str.Sequential[V],
+) PriorityQueueLike[V] {
+	return PriorityQueueClass[V]().PriorityQueueFromSequence(
+		values,
+	)
+}
+
+func PriorityQueueFromString[V any](
+	source string,
+) PriorityQueueLike[V] {
+	return PriorityQueueClass[V]().PriorityQueueFromString(
+		source,
+	)
+}
+
 func QueueClass[V any]() QueueClassLike[V] {
 	return col.QueueClass[V]()
 }
@@ -401,6 +901,14 @@ func QueueFromSequence[V any](
 	)
 }
 
+func QueueFromString[V any](
+	source string,
+) QueueLike[V] {
+	return QueueClass[V]().QueueFromString(
+		source,
+	)
+}
+
 func SetClass[V any]() SetClassLike[V] {
 	return col.SetClass[V]()
 }
@@ -417,6 +925,18 @@ func SetWithCollator[V any](
 	)
 }
 
+func TreeSet[V any]() SetLike[V] {
+	return SetClass[V]().TreeSet()
+}
+
+func TreeSetWithCollator[V any](
+	collator age.CollatorLike[V],
+) SetLike[V] {
+	return SetClass[V]().TreeSetWithCollator(
+		collator,
+	)
+}
+
 func SetFromArray[V any](
 	values []V,
 ) SetLike[V] {
@@ -433,6 +953,14 @@ func SetFromSequence[V any](
 	)
 }
 
+func SetFromString[V any](
+	source string,
+) SetLike[V] {
+	return SetClass[V]().SetFromString(
+		source,
+	)
+}
+
 func StackClass[V any]() StackClassLike[V] {
 	return col.StackClass[V]()
 }
@@ -465,6 +993,14 @@ func StackFromSequence[V any](
 	)
 }
 
+func StackFromString[V any](
+	source string,
+) StackLike[V] {
+	return StackClass[V]().StackFromString(
+		source,
+	)
+}
+
 // Elements
 
 func AngleClass() AngleClassLike {
@@ -487,6 +1023,17 @@ func AngleFromString(
 	)
 }
 
+func ParseAngle(
+	source string,
+) (
+	angle AngleLike,
+	err error,
+) {
+	return AngleClass().ParseAngle(
+		source,
+	)
+}
+
 func BooleanClass() BooleanClassLike {
 	return ele.BooleanClass()
 }
@@ -507,6 +1054,74 @@ func BooleanFromString(
 	)
 }
 
+func ParseBoolean(
+	source string,
+) (
+	boolean BooleanLike,
+	err error,
+) {
+	return BooleanClass().ParseBoolean(
+		source,
+	)
+}
+
+func DecimalClass() DecimalClassLike {
+	return ele.DecimalClass()
+}
+
+func Decimal(
+	unscaled *big.Int,
+	scale int,
+) DecimalLike {
+	return DecimalClass().Decimal(
+		unscaled,
+		scale,
+	)
+}
+
+func DecimalFromInteger(
+	integer int,
+) DecimalLike {
+	return DecimalClass().DecimalFromInteger(
+		integer,
+	)
+}
+
+func DecimalFromFloat(
+	float float64,
+) DecimalLike {
+	return DecimalClass().DecimalFromFloat(
+		float,
+	)
+}
+
+func DecimalFromNumber(
+	number NumberLike,
+) DecimalLike {
+	return DecimalClass().DecimalFromNumber(
+		number,
+	)
+}
+
+func DecimalFromString(
+	source string,
+) DecimalLike {
+	return DecimalClass().DecimalFromString(
+		source,
+	)
+}
+
+func ParseDecimal(
+	source string,
+) (
+	decimal DecimalLike,
+	err error,
+) {
+	return DecimalClass().ParseDecimal(
+		source,
+	)
+}
+
 func DurationClass() DurationClassLike {
 	return ele.DurationClass()
 }
@@ -527,6 +1142,25 @@ func DurationFromString(
 	)
 }
 
+func ParseDuration(
+	source string,
+) (
+	duration DurationLike,
+	err error,
+) {
+	return DurationClass().ParseDuration(
+		source,
+	)
+}
+
+func ElementParserClass() ElementParserClassLike {
+	return ele.ElementParserClass()
+}
+
+func ElementParser() ElementParserLike {
+	return ElementParserClass().ElementParser()
+}
+
 func GlyphClass() GlyphClassLike {
 	return ele.GlyphClass()
 }
@@ -555,6 +1189,66 @@ func GlyphFromString(
 	)
 }
 
+func ParseGlyph(
+	source string,
+) (
+	glyph GlyphLike,
+	err error,
+) {
+	return GlyphClass().ParseGlyph(
+		source,
+	)
+}
+
+func InstantClass() InstantClassLike {
+	return ele.InstantClass()
+}
+
+func Instant(
+	seconds int,
+	nanoseconds int,
+) InstantLike {
+	return InstantClass().Instant(
+		seconds,
+		nanoseconds,
+	)
+}
+
+func InstantFromMoment(
+	moment ele.MomentLike,
+) InstantLike {
+	return InstantClass().InstantFromMoment(
+		moment,
+	)
+}
+
+func InstantFromTime(
+	time tim.Time,
+) InstantLike {
+	return InstantClass().InstantFromTime(
+		time,
+	)
+}
+
+func InstantFromString(
+	source string,
+) InstantLike {
+	return InstantClass().InstantFromString(
+		source,
+	)
+}
+
+func ParseInstant(
+	source string,
+) (
+	instant InstantLike,
+	err error,
+) {
+	return InstantClass().ParseInstant(
+		source,
+	)
+}
+
 func MomentClass() MomentClassLike {
 	return ele.MomentClass()
 }
@@ -575,6 +1269,68 @@ func MomentFromString(
 	)
 }
 
+func ParseMoment(
+	source string,
+) (
+	moment MomentLike,
+	err error,
+) {
+	return MomentClass().ParseMoment(
+		source,
+	)
+}
+
+func MomentFromTime(
+	time tim.Time,
+) MomentLike {
+	return MomentClass().MomentFromTime(
+		time,
+	)
+}
+
+func MoneyClass() MoneyClassLike {
+	return ele.MoneyClass()
+}
+
+func Money(
+	currency string,
+	minorUnits int64,
+) MoneyLike {
+	return MoneyClass().Money(
+		currency,
+		minorUnits,
+	)
+}
+
+func MoneyFromDecimal(
+	currency string,
+	amount DecimalLike,
+) MoneyLike {
+	return MoneyClass().MoneyFromDecimal(
+		currency,
+		amount,
+	)
+}
+
+func MoneyFromString(
+	source string,
+) MoneyLike {
+	return MoneyClass().MoneyFromString(
+		source,
+	)
+}
+
+func ParseMoney(
+	source string,
+) (
+	money MoneyLike,
+	err error,
+) {
+	return MoneyClass().ParseMoney(
+		source,
+	)
+}
+
 func NumberClass() NumberClassLike {
 	return ele.NumberClass()
 }
@@ -631,6 +1387,17 @@ func NumberFromString(
 	)
 }
 
+func ParseNumber(
+	source string,
+) (
+	number NumberLike,
+	err error,
+) {
+	return NumberClass().ParseNumber(
+		source,
+	)
+}
+
 func PercentageClass() PercentageClassLike {
 	return ele.PercentageClass()
 }
@@ -659,6 +1426,17 @@ func PercentageFromString(
 	)
 }
 
+func ParsePercentage(
+	source string,
+) (
+	percentage PercentageLike,
+	err error,
+) {
+	return PercentageClass().ParsePercentage(
+		source,
+	)
+}
+
 func ProbabilityClass() ProbabilityClassLike {
 	return ele.ProbabilityClass()
 }
@@ -687,6 +1465,115 @@ func ProbabilityFromString(
 	)
 }
 
+func ParseProbability(
+	source string,
+) (
+	probability ProbabilityLike,
+	err error,
+) {
+	return ProbabilityClass().ParseProbability(
+		source,
+	)
+}
+
+func QuantityClass() QuantityClassLike {
+	return ele.QuantityClass()
+}
+
+func Quantity(
+	magnitude NumberLike,
+	units string,
+) QuantityLike {
+	return QuantityClass().Quantity(
+		magnitude,
+		units,
+	)
+}
+
+func QuantityFromString(
+	source string,
+) QuantityLike {
+	return QuantityClass().QuantityFromString(
+		source,
+	)
+}
+
+func ParseQuantity(
+	source string,
+) (
+	quantity QuantityLike,
+	err error,
+) {
+	return QuantityClass().ParseQuantity(
+		source,
+	)
+}
+
+func RationalClass() RationalClassLike {
+	return ele.RationalClass()
+}
+
+func Rational(
+	rational *big.Rat,
+) RationalLike {
+	return RationalClass().Rational(
+		rational,
+	)
+}
+
+func RationalFromFraction(
+	numerator int,
+	denominator int,
+) RationalLike {
+	return RationalClass().RationalFromFraction(
+		numerator,
+		denominator,
+	)
+}
+
+func RationalFromInteger(
+	integer int,
+) RationalLike {
+	return RationalClass().RationalFromInteger(
+		integer,
+	)
+}
+
+func RationalFromFloat(
+	float float64,
+) RationalLike {
+	return RationalClass().RationalFromFloat(
+		float,
+	)
+}
+
+func RationalFromDecimal(
+	decimal DecimalLike,
+) RationalLike {
+	return RationalClass().RationalFromDecimal(
+		decimal,
+	)
+}
+
+func RationalFromString(
+	source string,
+) RationalLike {
+	return RationalClass().RationalFromString(
+		source,
+	)
+}
+
+func ParseRational(
+	source string,
+) (
+	rational RationalLike,
+	err error,
+) {
+	return RationalClass().ParseRational(
+		source,
+	)
+}
+
 func ResourceClass() ResourceClassLike {
 	return ele.ResourceClass()
 }
@@ -707,6 +1594,17 @@ func ResourceFromString(
 	)
 }
 
+func ParseResource(
+	source string,
+) (
+	resource ResourceLike,
+	err error,
+) {
+	return ResourceClass().ParseResource(
+		source,
+	)
+}
+
 func ResourceFromUri(
 	url *uri.URL,
 ) ResourceLike {
@@ -715,6 +1613,45 @@ func ResourceFromUri(
 	)
 }
 
+func SpanClass() SpanClassLike {
+	return ele.SpanClass()
+}
+
+func Span(
+	duration tim.Duration,
+) SpanLike {
+	return SpanClass().Span(
+		duration,
+	)
+}
+
+func SpanFromDuration(
+	duration ele.DurationLike,
+) SpanLike {
+	return SpanClass().SpanFromDuration(
+		duration,
+	)
+}
+
+func SpanFromString(
+	source string,
+) SpanLike {
+	return SpanClass().SpanFromString(
+		source,
+	)
+}
+
+func ParseSpan(
+	source string,
+) (
+	span SpanLike,
+	err error,
+) {
+	return SpanClass().ParseSpan(
+		source,
+	)
+}
+
 func SymbolClass() SymbolClassLike {
 	return ele.SymbolClass()
 }
@@ -735,6 +1672,17 @@ func SymbolFromString(
 	)
 }
 
+func ParseSymbol(
+	source string,
+) (
+	symbol SymbolLike,
+	err error,
+) {
+	return SymbolClass().ParseSymbol(
+		source,
+	)
+}
+
 // Ranges
 
 func ContinuumClass[V ele.Continuous]() ContinuumClassLike[V] {
@@ -755,6 +1703,17 @@ func Continuum[V ele.Continuous](
 	)
 }
 
+func ParseContinuum[V ele.Continuous](
+	source string,
+) (
+	continuum ContinuumLike[V],
+	err error,
+) {
+	return ContinuumClass[V]().ParseContinuum(
+		source,
+	)
+}
+
 func IntervalClass[V ele.Discrete]() IntervalClassLike[V] {
 	return ran.IntervalClass[V]()
 }
@@ -773,6 +1732,17 @@ func Interval[V ele.Discrete](
 	)
 }
 
+func ParseInterval[V ele.Discrete](
+	source string,
+) (
+	interval IntervalLike[V],
+	err error,
+) {
+	return IntervalClass[V]().ParseInterval(
+		source,
+	)
+}
+
 func SpectrumClass[V str.Spectral[V]]() SpectrumClassLike[V] {
 	return ran.SpectrumClass[V]()
 }
@@ -791,6 +1761,17 @@ func Spectrum[V str.Spectral[V]](
 	)
 }
 
+func ParseSpectrum[V str.Spectral[V]](
+	source string,
+) (
+	spectrum SpectrumLike[V],
+	err error,
+) {
+	return SpectrumClass[V]().ParseSpectrum(
+		source,
+	)
+}
+
 // Strings
 
 func BinaryClass() BinaryClassLike {
@@ -821,6 +1802,17 @@ func BinaryFromString(
 	)
 }
 
+func ParseBinary(
+	source string,
+) (
+	binary BinaryLike,
+	err error,
+) {
+	return BinaryClass().ParseBinary(
+		source,
+	)
+}
+
 func NameClass() NameClassLike {
 	return str.NameClass()
 }
@@ -849,6 +1841,17 @@ func NameFromString(
 	)
 }
 
+func ParseName(
+	source string,
+) (
+	name NameLike,
+	err error,
+) {
+	return NameClass().ParseName(
+		source,
+	)
+}
+
 func NarrativeClass() NarrativeClassLike {
 	return str.NarrativeClass()
 }
@@ -877,6 +1880,17 @@ func NarrativeFromString(
 	)
 }
 
+func ParseNarrative(
+	source string,
+) (
+	narrative NarrativeLike,
+	err error,
+) {
+	return NarrativeClass().ParseNarrative(
+		source,
+	)
+}
+
 func PatternClass() PatternClassLike {
 	return str.PatternClass()
 }
@@ -905,6 +1919,17 @@ func PatternFromString(
 	)
 }
 
+func ParsePattern(
+	source string,
+) (
+	pattern PatternLike,
+	err error,
+) {
+	return PatternClass().ParsePattern(
+		source,
+	)
+}
+
 func QuoteClass() QuoteClassLike {
 	return str.QuoteClass()
 }
@@ -933,6 +1958,25 @@ func QuoteFromString(
 	)
 }
 
+func ParseQuote(
+	source string,
+) (
+	quote QuoteLike,
+	err error,
+) {
+	return QuoteClass().ParseQuote(
+		source,
+	)
+}
+
+func StringParserClass() StringParserClassLike {
+	return str.StringParserClass()
+}
+
+func StringParser() StringParserLike {
+	return StringParserClass().StringParser()
+}
+
 func TagClass() TagClassLike {
 	return str.TagClass()
 }
@@ -969,6 +2013,17 @@ func TagFromString(
 	)
 }
 
+func ParseTag(
+	source string,
+) (
+	tag TagLike,
+	err error,
+) {
+	return TagClass().ParseTag(
+		source,
+	)
+}
+
 func VersionClass() VersionClassLike {
 	return str.VersionClass()
 }
@@ -997,8 +2052,45 @@ func VersionFromString(
 	)
 }
 
+func ParseVersion(
+	source string,
+) (
+	version VersionLike,
+	err error,
+) {
+	return VersionClass().ParseVersion(
+		source,
+	)
+}
+
 // GLOBAL FUNCTIONS
 
+/*
+ParseLiteral returns the element, string or collection whose literal comes
+next in the specified source string.  Any leading whitespace is skipped and the
+literal found at that position is parsed into its typed value (e.g. NumberLike,
+MomentLike, TagLike, ListLike[any], etc.).  When the literal could be either an
+element or a string the longest match wins (see the ParseValue() method of the
+collection parser).  The byte position of the literal within the source string
+and its length in bytes are also returned.  If no literal begins at that
+position the returned literal is nil and the length is zero.
+*/
+func ParseLiteral(
+	source string,
+) (
+	literal any,
+	position uint,
+	length uint,
+) {
+	// Skip over any leading whitespace.
+	var trimmed = sts.TrimLeftFunc(source, uni.IsSpace)
+	position = uint(len(source) - len(trimmed))
+
+	// Parse the collection, element or string literal at that position.
+	literal, length = CollectionParser().ParseValue(trimmed)
+	return
+}
+
 func Now() MomentLike {
 	return ele.MomentClass().Now()
 }
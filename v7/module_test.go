@@ -13,13 +13,18 @@
 package module_test
 
 import (
+	ctx "context"
+	jsn "encoding/json"
 	fmt "fmt"
 	fra "github.com/craterdog/go-component-framework/v7"
 	ass "github.com/stretchr/testify/assert"
 	mat "math"
+	big "math/big"
 	cmp "math/cmplx"
+	sts "strings"
 	syn "sync"
 	tes "testing"
+	tim "time"
 )
 
 func TestModuleFunctions(t *tes.T) {
@@ -1906,6 +1911,575 @@ func TestQueueWithInvalidJoin(t *tes.T) {
 	fra.QueueClass[int]().Join(group, inputs) // Should panic here.
 }
 
+func TestQueueWithStatus(t *tes.T) {
+	// Try to add and remove values without blocking.
+	var queue = fra.QueueWithCapacity[int](2)
+	var value, status = queue.TryRemoveFirst()
+	ass.Equal(t, fra.Blocked, status)
+	ass.Equal(t, 0, value)
+	ass.Equal(t, fra.Succeeded, queue.TryAddValue(1))
+	ass.Equal(t, fra.Succeeded, queue.TryAddValue(2))
+	ass.Equal(t, fra.Blocked, queue.TryAddValue(3))
+	ass.Equal(t, []int{1, 2}, queue.AsArray())
+
+	// Wait for a limited time to add and remove values.
+	var timeout = fra.Duration(10)
+	ass.Equal(t, fra.TimedOut, queue.AddValueWithTimeout(3, timeout))
+	value, status = queue.RemoveFirstWithTimeout(timeout)
+	ass.Equal(t, fra.Succeeded, status)
+	ass.Equal(t, 1, value)
+	ass.Equal(t, fra.Succeeded, queue.AddValueWithTimeout(3, timeout))
+	ass.Equal(t, []int{2, 3}, queue.AsArray())
+
+	// Wait for a context to add and remove values.
+	var context, cancel = ctx.WithCancel(ctx.Background())
+	cancel()
+	ass.Equal(t, fra.Cancelled, queue.AddValueWithContext(context, 4))
+	context, cancel = ctx.WithTimeout(ctx.Background(), 10*tim.Millisecond)
+	defer cancel()
+	ass.Equal(t, fra.TimedOut, queue.AddValueWithContext(context, 4))
+	value, status = queue.RemoveFirstWithContext(ctx.Background())
+	ass.Equal(t, fra.Succeeded, status)
+	ass.Equal(t, 2, value)
+	value, status = queue.RemoveFirstWithContext(ctx.Background())
+	ass.Equal(t, fra.Succeeded, status)
+	ass.Equal(t, 3, value)
+	value, status = queue.RemoveFirstWithContext(context)
+	ass.Equal(t, fra.TimedOut, status)
+	ass.True(t, queue.IsEmpty())
+
+	// A waiting producer is released when the queue is closed.
+	var group = new(syn.WaitGroup)
+	queue.AddValue(5)
+	queue.AddValue(6)
+	group.Go(func() {
+		ass.Equal(t, fra.Closed, queue.AddValueWithContext(ctx.Background(), 7))
+	})
+	tim.Sleep(10 * tim.Millisecond)
+	queue.CloseChannel()
+	queue.CloseChannel() // Closing a queue again has no effect.
+	group.Wait()
+	ass.Equal(t, fra.Closed, queue.TryAddValue(8))
+	value, status = queue.TryRemoveFirst()
+	ass.Equal(t, fra.Succeeded, status)
+	ass.Equal(t, 5, value)
+	value, _ = queue.RemoveFirst()
+	ass.Equal(t, 6, value)
+	_, status = queue.TryRemoveFirst()
+	ass.Equal(t, fra.Closed, status)
+	_, status = queue.RemoveFirstWithTimeout(timeout)
+	ass.Equal(t, fra.Closed, status)
+}
+
+func TestQueueAddAfterClose(t *tes.T) {
+	var queue = fra.Queue[int]()
+	queue.CloseChannel()
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "A value cannot be added to a closed queue.", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	queue.AddValue(1) // Should panic here.
+}
+
+func TestQueueTeardown(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group fra.Synchronized = new(syn.WaitGroup)
+	defer group.Wait()
+
+	// Create a pipeline that splits the input queue and joins it back up.
+	var input = fra.QueueWithCapacity[int](3)
+	var split = fra.QueueClass[int]().Split(group, input, 3)
+	var output = fra.QueueClass[int]().Join(group, split)
+
+	// Add values to the input queue in the background until it is closed.
+	group.Go(func() {
+		for i := 1; ; i++ {
+			if input.AddValueWithContext(ctx.Background(), i) == fra.Closed {
+				break
+			}
+		}
+	})
+
+	// Remove some values from the output queue and then tear down the pipeline.
+	for i := 1; i < 11; i++ {
+		var value, _ = output.RemoveFirst()
+		ass.Equal(t, i, value)
+	}
+	output.CloseChannel()
+}
+
+func TestQueueJoinWithClosedInput(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group fra.Synchronized = new(syn.WaitGroup)
+	defer group.Wait()
+
+	// Join two input queues and close the first one right away.
+	var first = fra.QueueWithCapacity[int](3)
+	var second = fra.QueueWithCapacity[int](3)
+	var output = fra.QueueClass[int]().Join(group, fra.ListFromArray([]fra.QueueLike[int]{first, second}))
+	first.AddValue(1)
+	first.CloseChannel()
+
+	// The join keeps draining the second input queue without closing it.
+	for i := 2; i < 8; i++ {
+		second.AddValue(i) // Should not panic.
+	}
+	for i := 1; i < 8; i++ {
+		var value, ok = output.RemoveFirst()
+		ass.True(t, ok)
+		ass.Equal(t, i, value)
+	}
+	var _, status = output.TryRemoveFirst()
+	ass.Equal(t, fra.Blocked, status) // The output queue is still open.
+	second.CloseChannel()
+	var _, ok = output.RemoveFirst()
+	ass.False(t, ok)
+}
+
+func TestQueueInputsOutliveGroup(t *tes.T) {
+	// Create a pipeline whose group fails.
+	var group = fra.Group()
+	var class = fra.QueueClass[int]()
+	var input = fra.QueueWithCapacity[int](3)
+	var outputs = class.Fork(group, input, 2)
+	var mapped = class.Map(group, outputs.AsArray()[0], func(value int) int { return -value })
+	group.GoWithError(func() error {
+		return fmt.Errorf("The pipeline has failed.")
+	})
+	ass.Equal(t, "The pipeline has failed.", group.WaitForError().Error())
+
+	// The output queues are closed but the input queue still belongs to the caller.
+	var _, ok = mapped.RemoveFirst()
+	ass.False(t, ok)
+	ass.Equal(t, fra.Succeeded, input.TryAddValue(1))
+	input.AddValue(2) // Should not panic.
+	ass.Equal(t, []int{1, 2}, input.AsArray())
+}
+
+func TestQueueRemoveAll(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group = new(syn.WaitGroup)
+	defer group.Wait()
+
+	// Fill up a queue and then add another value in the background.
+	var queue = fra.QueueWithCapacity[int](2)
+	queue.AddValue(1)
+	queue.AddValue(2)
+	group.Go(func() {
+		queue.AddValue(3) // Will block until the queue is emptied.
+	})
+	tim.Sleep(10 * tim.Millisecond)
+	ass.Equal(t, []int{1, 2}, queue.AsArray())
+
+	// Removing all values wakes up the blocked producer.
+	queue.RemoveAll()
+	var value, _ = queue.RemoveFirst()
+	ass.Equal(t, 3, value)
+	ass.True(t, queue.IsEmpty())
+}
+
+func TestQueueWithMapAndFilter(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group fra.Synchronized = new(syn.WaitGroup)
+	defer group.Wait()
+
+	// Create a pipeline that squares the values and then keeps the even ones.
+	var input = fra.QueueWithCapacity[int](3)
+	var class = fra.QueueClass[int]()
+	var squares = class.Map(group, input, func(value int) int {
+		return value * value
+	})
+	var output = class.Filter(group, squares, func(value int) bool {
+		return value%2 == 0
+	})
+
+	// Remove values from the output queue in the background.
+	group.Go(func() {
+		var values []int
+		for {
+			var value, ok = output.RemoveFirst()
+			if !ok {
+				break
+			}
+			values = append(values, value)
+		}
+		ass.Equal(t, []int{4, 16, 36, 64, 100}, values)
+	})
+
+	// Add values to the input queue.
+	for i := 1; i < 11; i++ {
+		input.AddValue(i)
+	}
+	input.CloseChannel()
+}
+
+func TestQueueWithBatch(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group fra.Synchronized = new(syn.WaitGroup)
+	defer group.Wait()
+
+	// Create a pipeline that batches the values in threes.
+	var input = fra.QueueWithCapacity[int](3)
+	var output = fra.BatcherClass[int]().Batch(group, input, 3)
+
+	// Remove batches from the output queue in the background.
+	group.Go(func() {
+		var batches []string
+		for {
+			var batch, ok = output.RemoveFirst()
+			if !ok {
+				break
+			}
+			batches = append(batches, fmt.Sprintf("%v", batch))
+		}
+		ass.Equal(t, []string{"[1, 2, 3]", "[4, 5, 6]", "[7, 8, 9]", "[10]"}, batches)
+	})
+
+	// Add values to the input queue.
+	for i := 1; i < 11; i++ {
+		input.AddValue(i)
+	}
+	input.CloseChannel()
+}
+
+func TestQueueWithWindow(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group fra.Synchronized = new(syn.WaitGroup)
+	defer group.Wait()
+
+	// Create a pipeline that gathers the values arriving within 50 milliseconds.
+	var input = fra.QueueWithCapacity[int](8)
+	var output = fra.BatcherClass[int]().Window(group, input, fra.Duration(50))
+
+	// Add two bursts of values to the input queue.
+	for i := 1; i < 4; i++ {
+		input.AddValue(i)
+	}
+	var batch, _ = output.RemoveFirst() // Will block until the window ends.
+	ass.Equal(t, []int{1, 2, 3}, batch.AsArray())
+	for i := 4; i < 6; i++ {
+		input.AddValue(i)
+	}
+	batch, _ = output.RemoveFirst() // Will block until the window ends.
+	ass.Equal(t, []int{4, 5}, batch.AsArray())
+
+	// Closing the input queue ends the current window early.
+	input.AddValue(6)
+	input.CloseChannel()
+	batch, _ = output.RemoveFirst()
+	ass.Equal(t, []int{6}, batch.AsArray())
+	var _, ok = output.RemoveFirst()
+	ass.False(t, ok)
+}
+
+func TestQueueWithInvalidBatch(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group fra.Synchronized = new(syn.WaitGroup)
+	defer group.Wait()
+
+	// Create a new batch with an invalid size.
+	var input = fra.QueueWithCapacity[int](3)
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "The size of a batch must be greater than zero.", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	fra.BatcherClass[int]().Batch(group, input, 0) // Should panic here.
+}
+
+func TestQueueWithInvalidWindow(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group fra.Synchronized = new(syn.WaitGroup)
+	defer group.Wait()
+
+	// Create a new window with an invalid duration.
+	var input = fra.QueueWithCapacity[int](3)
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "The duration of a window must be greater than zero.", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	fra.BatcherClass[int]().Window(group, input, fra.Duration(0)) // Should panic here.
+}
+
+func TestQueueWithMapInParallel(t *tes.T) {
+	// Create a pipeline whose workers take different times to map each value.
+	var group = fra.Group()
+	var class = fra.QueueClass[int]()
+	var input = fra.QueueWithCapacity[int](4)
+	var output = class.MapInParallel(group, input, func(value int) int {
+		tim.Sleep(tim.Duration(value%3) * tim.Millisecond)
+		return value * value
+	}, 3)
+
+	// Add values to the input queue in the background.
+	group.Go(func() {
+		for value := 1; value <= 20; value++ {
+			input.AddValue(value) // Will block when full.
+		}
+		input.CloseChannel()
+	})
+
+	// The mapped values are removed in their original order.
+	var expected = 1
+	for {
+		var value, ok = output.RemoveFirst()
+		if !ok {
+			break
+		}
+		ass.Equal(t, expected*expected, value)
+		expected++
+	}
+	ass.Equal(t, 21, expected)
+	ass.Nil(t, group.WaitForError())
+
+	// A mapping that panics tears down the pipeline.
+	group = fra.Group()
+	input = fra.QueueWithCapacity[int](4)
+	output = class.MapInParallel(group, input, func(value int) int {
+		if value == 7 {
+			panic("seven")
+		}
+		return value
+	}, 2)
+	for value := 1; value < 1000; value++ {
+		if input.AddValueWithContext(group.GetContext(), value) != fra.Succeeded {
+			break
+		}
+	}
+	ass.Equal(t, "A go-routine in the group panicked: seven", group.WaitForError().Error())
+	for {
+		var value, ok = output.RemoveFirst()
+		if !ok {
+			break
+		}
+		ass.True(t, value < 7)
+	}
+}
+
+func TestQueueWithInvalidMapInParallel(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group fra.Synchronized = new(syn.WaitGroup)
+	defer group.Wait()
+
+	// Create a new parallel map with too few workers.
+	var input = fra.QueueWithCapacity[int](3)
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "The number of workers for a parallel map must be greater than one.", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	fra.QueueClass[int]().MapInParallel(group, input, func(value int) int {
+		return value
+	}, 1) // Should panic here.
+}
+
+func TestGroups(t *tes.T) {
+	// A group without any failures.
+	var group = fra.Group()
+	var queue = fra.Queue[int]()
+	group.ConnectChannel(queue)
+	group.Go(func() {})
+	group.GoWithError(func() error { return nil })
+	ass.Nil(t, group.WaitForError())
+	ass.Equal(t, 0, len(group.GetErrors()))
+	ass.Equal(t, ctx.Canceled, group.GetContext().Err())
+	ass.Equal(t, fra.Succeeded, queue.TryAddValue(1))
+	ass.Nil(t, group.WaitForError()) // Waiting again records no error.
+	ass.Equal(t, 0, len(group.GetErrors()))
+
+	// A group with a go-routine that panics.
+	group = fra.Group()
+	queue = fra.Queue[int]()
+	group.ConnectChannel(queue)
+	group.Go(func() {
+		panic("boom")
+	})
+	ass.Equal(t, "A go-routine in the group panicked: boom", group.WaitForError().Error())
+	ass.NotNil(t, group.GetContext().Err())
+	ass.Equal(t, fra.Closed, queue.TryAddValue(1))
+
+	// Channels connected after a failure are closed immediately.
+	var deque = fra.BlockingDeque[int](2)
+	group.ConnectChannel(deque)
+	deque.CloseChannel() // Closing a deque again has no effect.
+	var _, ok = deque.RemoveFirst()
+	ass.False(t, ok)
+
+	// A group whose parent context is cancelled.
+	var context, cancel = ctx.WithCancel(ctx.Background())
+	group = fra.GroupWithContext(context)
+	queue = fra.Queue[int]()
+	group.ConnectChannel(queue)
+	group.Go(func() {
+		for {
+			var _, ok = queue.RemoveFirst() // Will block until the queue is closed.
+			if !ok {
+				break
+			}
+		}
+	})
+	cancel()
+	ass.Equal(t, ctx.Canceled, group.WaitForError())
+}
+
+func TestGroupFinishing(t *tes.T) {
+	// The cause of a cancelled parent context is always reported.
+	for range 100 {
+		var context, cancel = ctx.WithCancel(ctx.Background())
+		var group = fra.GroupWithContext(context)
+		var queue = fra.Queue[int]()
+		group.ConnectChannel(queue)
+		cancel()
+		ass.Equal(t, ctx.Canceled, group.WaitForError())
+		ass.Equal(t, fra.Closed, queue.TryAddValue(1))
+	}
+
+	// A group that finished successfully releases its channels and context.
+	var context, cancel = ctx.WithCancel(ctx.Background())
+	var group = fra.GroupWithContext(context)
+	var queue = fra.Queue[int]()
+	group.ConnectChannel(queue)
+	group.Go(func() {})
+	group.Wait()
+	cancel()
+	tim.Sleep(10 * tim.Millisecond)
+	ass.Equal(t, fra.Succeeded, queue.TryAddValue(1))
+	ass.Equal(t, 0, len(group.GetErrors()))
+}
+
+func TestGroupWithPipeline(t *tes.T) {
+	// Create a pipeline that splits the input queue across three workers.
+	var group = fra.Group()
+	var class = fra.QueueClass[int]()
+	var input = fra.QueueWithCapacity[int](3)
+	var split = class.Split(group, input, 3)
+	var results = fra.List[fra.QueueLike[int]]()
+	var iterator = split.GetIterator()
+	for iterator.HasNext() {
+		var worker = iterator.GetNext()
+		var result = fra.QueueWithCapacity[int](3)
+		results.AppendValue(result)
+		group.GoWithError(func() error {
+			defer result.CloseChannel()
+			for {
+				var value, ok = worker.RemoveFirst()
+				if !ok {
+					return nil
+				}
+				if value == 5 {
+					return fmt.Errorf("The worker cannot process the value: %v", value)
+				}
+				result.AddValueWithContext(group.GetContext(), value)
+			}
+		})
+	}
+	var output = class.Join(group, results)
+
+	// Consume the output queue in the background.
+	group.Go(func() {
+		for {
+			var _, ok = output.RemoveFirst()
+			if !ok {
+				break
+			}
+		}
+	})
+
+	// Add values to the input queue until the pipeline is torn down.
+	var count int
+	for i := 1; i < 1000; i++ {
+		if input.AddValueWithContext(group.GetContext(), i) != fra.Succeeded {
+			break
+		}
+		count++
+	}
+	ass.True(t, count < 999)
+	var err = group.WaitForError()
+	ass.Equal(t, "The worker cannot process the value: 5", err.Error())
+	ass.Equal(t, err, group.GetErrors()[0])
+}
+
+func BenchmarkRankValues(b *tes.B) {
+	b.Run("strings", func(b *tes.B) {
+		var collator = fra.Collator[string]()
+		for b.Loop() {
+			collator.RankValues("alpha", "beta")
+		}
+	})
+	b.Run("integers", func(b *tes.B) {
+		var collator = fra.Collator[int]()
+		for b.Loop() {
+			collator.RankValues(42, 17)
+		}
+	})
+	b.Run("any", func(b *tes.B) {
+		var collator = fra.Collator[any]()
+		for b.Loop() {
+			collator.RankValues("alpha", 17)
+		}
+	})
+	b.Run("symbols", func(b *tes.B) {
+		var collator = fra.Collator[fra.SymbolLike]()
+		var first = fra.SymbolFromString("$alpha")
+		var second = fra.SymbolFromString("$beta")
+		for b.Loop() {
+			collator.RankValues(first, second)
+		}
+	})
+	b.Run("decimals", func(b *tes.B) {
+		var collator = fra.Collator[fra.DecimalLike]()
+		var first = fra.DecimalFromString("1.25")
+		var second = fra.DecimalFromString("1.5")
+		for b.Loop() {
+			collator.RankValues(first, second)
+		}
+	})
+}
+
+func BenchmarkQueueThroughput(b *tes.B) {
+	for _, workers := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("%dx%d", workers, workers), func(b *tes.B) {
+			// Remove values from the queue using several consumers.
+			var queue = fra.QueueWithCapacity[int](64)
+			var consumers = new(syn.WaitGroup)
+			for range workers {
+				consumers.Go(func() {
+					for {
+						var _, ok = queue.RemoveFirst() // Will block when empty.
+						if !ok {
+							break // The queue has been closed.
+						}
+					}
+				})
+			}
+
+			// Add values to the queue using the same number of producers.
+			b.ResetTimer()
+			var producers = new(syn.WaitGroup)
+			for worker := range workers {
+				producers.Go(func() {
+					for value := worker; value < b.N; value += workers {
+						queue.AddValue(value) // Will block when full.
+					}
+				})
+			}
+			producers.Wait()
+			queue.CloseChannel()
+			consumers.Wait()
+		})
+	}
+}
+
 func TestSetConstructors(t *tes.T) {
 	var collator = fra.Collator[int64]()
 	fra.Set[int64]()
@@ -2331,7 +2905,7 @@ var one uint = 1
 func TestZeroDurations(t *tes.T) {
 	var v = fra.Duration(0)
 	ass.Equal(t, 0, v.AsInteger())
-	ass.Equal(t, zero, v.AsIntrinsic())
+	ass.Equal(t, 0, v.AsIntrinsic())
 	ass.Equal(t, 0.0, v.AsMilliseconds())
 	ass.Equal(t, 0.0, v.AsSeconds())
 	ass.Equal(t, 0.0, v.AsMinutes())
@@ -2361,7 +2935,7 @@ func TestDurations(t *tes.T) {
 	var v = fra.Duration(60000)
 	ass.Equal(t, "~PT1M", v.AsString())
 	ass.Equal(t, 60000, v.AsInteger())
-	ass.Equal(t, uint(60000), v.AsIntrinsic())
+	ass.Equal(t, 60000, v.AsIntrinsic())
 	ass.Equal(t, 60000.0, v.AsMilliseconds())
 	ass.Equal(t, 60.0, v.AsSeconds())
 	ass.Equal(t, 1.0, v.AsMinutes())
@@ -3503,3 +4077,2131 @@ func TestContinuumConstructors(t *tes.T) {
 	)
 	ass.Equal(t, "[~0..~τ)", fmt.Sprintf("%v", angles))
 }
+
+func TestParseLiteral(t *tes.T) {
+	var literals = []string{
+		"~π",
+		"false",
+		"~P3D",
+		"'a'",
+		"<2024-03-02T12:30>",
+		"-1.2-3.4i",
+		"50%",
+		"p0.5",
+		"<https://craterdog.com/about>",
+		"$symbol",
+		"'>\n    abcd1234\n<'",
+		"/a/b/c",
+		"\">\n    This is a narrative.\n<\"",
+		`"b[aeiou]g"?`,
+		`"To be or not to be..."`,
+		"#ABCDFGHJKLMNPQRSTVWX",
+		"v1.2.3",
+	}
+	for _, source := range literals {
+		var literal, position, length = fra.ParseLiteral("  " + source + ", ...")
+		ass.Equal(t, 2, int(position))
+		ass.Equal(t, len(source), int(length))
+		ass.Equal(t, source, fmt.Sprintf("%v", literal))
+	}
+
+	var literal, _, _ = fra.ParseLiteral("<2024-03-02>")
+	var moment, ok = literal.(fra.MomentLike)
+	ass.True(t, ok)
+	ass.Equal(t, "<2024-03-02>", moment.AsString())
+
+	literal, _, _ = fra.ParseLiteral("50%")
+	var percentage fra.PercentageLike
+	percentage, ok = literal.(fra.PercentageLike)
+	ass.True(t, ok)
+	ass.Equal(t, 0.5, percentage.AsIntrinsic())
+
+	literal, _, _ = fra.ParseLiteral("#ABCDFGHJKLMNPQRSTVWX")
+	_, ok = literal.(fra.TagLike)
+	ass.True(t, ok)
+
+	literal, _, _ = fra.ParseLiteral(`">quote<"`)
+	_, ok = literal.(fra.NarrativeLike)
+	ass.True(t, ok)
+}
+
+func TestParseLiteralWithoutLiteral(t *tes.T) {
+	var literal, position, length = fra.ParseLiteral("   @foo")
+	ass.Equal(t, nil, literal)
+	ass.Equal(t, 3, int(position))
+	ass.Equal(t, 0, int(length))
+
+	literal, position, length = fra.ParseLiteral("")
+	ass.Equal(t, nil, literal)
+	ass.Equal(t, 0, int(position))
+	ass.Equal(t, 0, int(length))
+}
+
+func TestCollectionParserValues(t *tes.T) {
+	var parser = fra.CollectionParser()
+
+	// An element takes precedence over a string of the same length.
+	var value, length = parser.ParseValue("50%, ...")
+	var _, ok = value.(fra.PercentageLike)
+	ass.True(t, ok)
+	ass.Equal(t, 3, int(length))
+
+	// A collection literal is parsed into a collection.
+	value, length = parser.ParseValue("[1, 2] ...")
+	_, ok = value.(fra.ListLike[any])
+	ass.True(t, ok)
+	ass.Equal(t, 6, int(length))
+
+	// A source string that does not begin with a literal.
+	value, length = parser.ParseValue("@foo")
+	ass.Equal(t, nil, value)
+	ass.Equal(t, 0, int(length))
+}
+
+func TestParseElements(t *tes.T) {
+	var angle, err = fra.ParseAngle("~π")
+	ass.Nil(t, err)
+	ass.Equal(t, "~π", angle.AsString())
+
+	var boolean, _ = fra.ParseBoolean("true")
+	ass.True(t, boolean.AsIntrinsic())
+
+	var duration, _ = fra.ParseDuration("~P3DT4H")
+	ass.Equal(t, "~P3DT4H", duration.AsString())
+
+	var glyph, _ = fra.ParseGlyph("'x'")
+	ass.Equal(t, "'x'", glyph.AsString())
+
+	var moment, _ = fra.ParseMoment("<2024-03-02T12:30>")
+	ass.Equal(t, "<2024-03-02T12:30>", moment.AsString())
+
+	var number, _ = fra.ParseNumber("-1.5E3")
+	ass.Equal(t, -1500.0, number.AsFloat())
+
+	var percentage, _ = fra.ParsePercentage("50%")
+	ass.Equal(t, 50.0, percentage.AsFloat())
+
+	var probability, _ = fra.ParseProbability("p0.25")
+	ass.Equal(t, 0.25, probability.AsFloat())
+
+	var resource, _ = fra.ParseResource("<https://craterdog.com/about>")
+	ass.Equal(t, "<https://craterdog.com/about>", resource.AsString())
+
+	var symbol, _ = fra.ParseSymbol("$foo")
+	ass.Equal(t, "$foo", symbol.AsString())
+}
+
+func TestParseElementsWithErrors(t *tes.T) {
+	var _, err = fra.ParseMoment("<2024-13-01>")
+	ass.NotNil(t, err)
+	var parseError, ok = err.(*fra.ParseError)
+	ass.True(t, ok)
+	ass.Equal(t, "Moment", parseError.Class)
+	ass.Equal(t, "<2024-13-01>", parseError.Source)
+	ass.Equal(t, 7, int(parseError.Offset))
+	ass.Equal(
+		t,
+		"An illegal Moment string was found at character 7: <2024-13-01>",
+		err.Error(),
+	)
+
+	_, err = fra.ParseMoment("<2024-02-30>")
+	ass.Equal(t, 1, int(err.(*fra.ParseError).Offset))
+
+	_, err = fra.ParseNumber("5x")
+	ass.Equal(t, 1, int(err.(*fra.ParseError).Offset))
+
+	_, err = fra.ParseDuration("~P3D4H")
+	ass.Equal(t, 4, int(err.(*fra.ParseError).Offset))
+
+	_, err = fra.ParseAngle("")
+	ass.Equal(t, 0, int(err.(*fra.ParseError).Offset))
+
+	_, err = fra.ParseGlyph("'x")
+	ass.Equal(t, 2, int(err.(*fra.ParseError).Offset))
+
+	_, err = fra.ParseSymbol("$föö!")
+	ass.Equal(t, 4, int(err.(*fra.ParseError).Offset))
+}
+
+func TestParseImpossibleElements(t *tes.T) {
+	// A literal that is well formed but not a real date is not an element.
+	var element, length = fra.ElementParser().ParseElement("<2024-02-30>")
+	ass.Equal(t, nil, element)
+	ass.Equal(t, 0, int(length))
+
+	var parser = fra.ElementParser()
+	element, length = parser.ParseElement("<2024-02-30T12:30:00.123456>")
+	ass.Equal(t, nil, element)
+	ass.Equal(t, 0, int(length))
+
+	element, length = fra.ElementParser().ParseElement("<2024-02-29>")
+	ass.Equal(t, "<2024-02-29>", element.(fra.MomentLike).AsString())
+	ass.Equal(t, 12, int(length))
+
+	var value, _ = fra.CollectionParser().ParseValue("[<2024-02-30>]")
+	ass.Equal(t, nil, value)
+}
+
+func TestParseStrings(t *tes.T) {
+	var binary, err = fra.ParseBinary("'>\n    abcd1234\n<'")
+	ass.Nil(t, err)
+	ass.Equal(t, 6, int(binary.GetSize()))
+
+	var name, _ = fra.ParseName("/nebula/types")
+	ass.Equal(t, "/nebula/types", name.AsString())
+
+	var narrative, _ = fra.ParseNarrative("\">\n    Hello\n<\"")
+	ass.Equal(t, "\">\n    Hello\n<\"", narrative.AsString())
+
+	var pattern, _ = fra.ParsePattern("any")
+	ass.Equal(t, "any", pattern.AsString())
+
+	var quote, _ = fra.ParseQuote(`"Hello"`)
+	ass.Equal(t, `"Hello"`, quote.AsString())
+
+	var tag, _ = fra.ParseTag("#ABCD")
+	ass.Equal(t, "#ABCD", tag.AsString())
+
+	var version, _ = fra.ParseVersion("v1.2.3")
+	ass.Equal(t, "v1.2.3", version.AsString())
+
+	_, err = fra.ParseVersion("v1.02")
+	ass.Equal(t, "Version", err.(*fra.ParseError).Class)
+	ass.Equal(t, 3, int(err.(*fra.ParseError).Offset))
+
+	_, err = fra.ParseTag("#ABCE")
+	ass.Equal(t, 4, int(err.(*fra.ParseError).Offset))
+
+	_, err = fra.ParseQuote(`"Hello`)
+	ass.Equal(t, 6, int(err.(*fra.ParseError).Offset))
+}
+
+func TestParseRanges(t *tes.T) {
+	var continuum, err = fra.ParseContinuum[fra.NumberLike]("[-1.23..4.56)")
+	ass.Nil(t, err)
+	ass.Equal(t, "[-1.23..4.56)", fmt.Sprintf("%v", continuum))
+
+	var interval, _ = fra.ParseInterval[fra.GlyphLike]("['A'..'F']")
+	ass.Equal(t, 6, int(interval.GetSize()))
+
+	var spectrum, _ = fra.ParseSpectrum[fra.VersionLike]("(v1.2.3..v2)")
+	ass.Equal(t, "(v1.2.3..v2)", fmt.Sprintf("%v", spectrum))
+
+	_, err = fra.ParseInterval[fra.GlyphLike]("['A'..'F'}")
+	ass.Equal(t, "Interval", err.(*fra.ParseError).Class)
+	ass.Equal(t, 9, int(err.(*fra.ParseError).Offset))
+
+	_, err = fra.ParseContinuum[fra.NumberLike]("[5..1]")
+	ass.Equal(t, 4, int(err.(*fra.ParseError).Offset))
+
+	_, err = fra.ParseSpectrum[fra.VersionLike]("[v1..$v2]")
+	ass.Equal(t, 5, int(err.(*fra.ParseError).Offset))
+}
+
+func TestCollectionLiterals(t *tes.T) {
+	var list = fra.ListFromString[fra.NumberLike]("[1, 2, 3]")
+	ass.Equal(t, 3, int(list.GetSize()))
+	ass.Equal(t, 2.0, list.GetValue(2).AsFloat())
+	ass.Equal(t, "[1, 2, 3]", fmt.Sprintf("%v", list))
+
+	var empty = fra.ListFromString[fra.NumberLike]("[ ]")
+	ass.True(t, empty.IsEmpty())
+	ass.Equal(t, "[]", fmt.Sprintf("%v", empty))
+
+	var set = fra.SetFromString[fra.VersionLike]("[v2, v1.2, v1]")
+	ass.Equal(t, "[v1, v1.2, v2]", fmt.Sprintf("%v", set))
+
+	var stack = fra.StackFromString[fra.SymbolLike]("[$top, $bottom]")
+	ass.Equal(t, "$top", stack.GetLast().AsString())
+	ass.Equal(t, "[$top, $bottom]", fmt.Sprintf("%v", stack))
+
+	var queue = fra.QueueFromString[fra.DurationLike]("[~P1D, ~P2D]")
+	ass.Equal(t, 2, int(queue.GetSize()))
+	ass.Equal(t, "[~P1D, ~P2D]", fmt.Sprintf("%v", queue))
+
+	var source = `["a": <2024-03-02T12:30>, "b": #ABCD]`
+	var catalog = fra.CatalogFromString[fra.QuoteLike, any](source)
+	ass.Equal(t, 2, int(catalog.GetSize()))
+	ass.Equal(t, source, fmt.Sprintf("%v", catalog))
+	var moment = catalog.GetValue(fra.QuoteFromString(`"a"`))
+	ass.Equal(t, "<2024-03-02T12:30>", moment.(fra.MomentLike).AsString())
+
+	var none = fra.CatalogFromString[fra.QuoteLike, any]("[:]")
+	ass.True(t, none.IsEmpty())
+	ass.Equal(t, "[:]", fmt.Sprintf("%v", none))
+}
+
+func TestNestedCollectionLiterals(t *tes.T) {
+	var source = `[
+    "numbers": [1, 2.5, -3],
+    "flags": [true: 'y', false: 'n'],
+    "empty": [:],
+    "name": /nebula/types
+]`
+	var catalog = fra.CatalogFromString[fra.QuoteLike, any](source)
+	var numbers = catalog.GetValue(fra.QuoteFromString(`"numbers"`))
+	ass.Equal(t, 3, int(numbers.(fra.ListLike[any]).GetSize()))
+	var flags = catalog.GetValue(fra.QuoteFromString(`"flags"`))
+	ass.Equal(t, "[true: 'y', false: 'n']", fmt.Sprintf("%v", flags))
+
+	var formatted = fmt.Sprintf("%v", catalog)
+	ass.Equal(
+		t,
+		`["numbers": [1, 2.5, -3], "flags": [true: 'y', false: 'n'], "empty": [:], "name": /nebula/types]`,
+		formatted,
+	)
+	var copy = fra.CatalogFromString[fra.QuoteLike, any](formatted)
+	ass.Equal(t, formatted, fmt.Sprintf("%v", copy))
+
+	var literal, position, length = fra.ParseLiteral("  [[1], [2, 3]] ...")
+	ass.Equal(t, 2, int(position))
+	ass.Equal(t, 13, int(length))
+	ass.Equal(t, "[[1], [2, 3]]", fmt.Sprintf("%v", literal))
+}
+
+func TestIllegalCollectionLiterals(t *tes.T) {
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(
+				t,
+				"An illegal string was passed to the list constructor method: [1, 2",
+				e,
+			)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	fra.ListFromString[fra.NumberLike]("[1, 2")
+}
+
+func TestMismatchedCollectionLiterals(t *tes.T) {
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(
+				t,
+				"An illegal string was passed to the set constructor method: [1, $two]",
+				e,
+			)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	fra.SetFromString[fra.NumberLike]("[1, $two]")
+}
+
+func TestPrimitivesJSON(t *tes.T) {
+	var moment = fra.MomentFromString("<2024-03-02T12:30>")
+	var bytes, err = jsn.Marshal(moment)
+	ass.Nil(t, err)
+	ass.Equal(t, `"\u003c2024-03-02T12:30\u003e"`, string(bytes)) // HTML safe.
+
+	bytes, _ = jsn.Marshal(fra.VersionFromString("v1.2"))
+	ass.Equal(t, `"v1.2"`, string(bytes))
+
+	var list = fra.List[fra.MomentLike]()
+	err = jsn.Unmarshal([]byte(`["<2024-03-02T12:30>", "<2024-03-05>"]`), list)
+	ass.Nil(t, err)
+	ass.Equal(t, 2, int(list.GetSize()))
+	ass.Equal(t, "<2024-03-05>", list.GetValue(2).AsString())
+
+	err = jsn.Unmarshal([]byte(`["<2024-03-02T12:30>", "v1.2"]`), list)
+	ass.NotNil(t, err)
+}
+
+func TestRangesJSON(t *tes.T) {
+	var continuum, _ = fra.ParseContinuum[fra.NumberLike]("[1..5)")
+	var bytes, err = jsn.Marshal(continuum)
+	ass.Nil(t, err)
+	ass.Equal(t, `"[1..5)"`, string(bytes))
+
+	var interval, _ = fra.ParseInterval[fra.GlyphLike]("['A'..'F']")
+	err = jsn.Unmarshal([]byte(`"('a'..'c')"`), interval)
+	ass.Nil(t, err)
+	ass.Equal(t, 1, int(interval.GetSize()))
+
+	err = jsn.Unmarshal([]byte(`"('a'..'c'}"`), interval)
+	ass.Equal(t, "Interval", err.(*fra.ParseError).Class)
+}
+
+func TestCatalogJSON(t *tes.T) {
+	var catalog = fra.Catalog[string, any]()
+	catalog.SetValue("b", fra.VersionFromString("v1.2"))
+	catalog.SetValue("a", fra.MomentFromString("<2024-03-02T12:30>"))
+	var bytes, err = jsn.Marshal(catalog)
+	ass.Nil(t, err)
+	var source = `{"b":"v1.2","a":"\u003c2024-03-02T12:30\u003e"}`
+	ass.Equal(t, source, string(bytes))
+
+	var copy_ = fra.Catalog[string, any]()
+	err = jsn.Unmarshal(bytes, copy_)
+	ass.Nil(t, err)
+	ass.Equal(t, "b", copy_.GetKeys().AsArray()[0])
+	ass.Equal(t, "v1.2", copy_.GetValue("b").(fra.VersionLike).AsString())
+	ass.Equal(t, "<2024-03-02T12:30>", fmt.Sprintf("%v", copy_.GetValue("a")))
+
+	var quotes = fra.Catalog[fra.QuoteLike, fra.DurationLike]()
+	err = jsn.Unmarshal([]byte(`{"\"z\"":"~P1D","\"y\"":"~PT2H"}`), quotes)
+	ass.Nil(t, err)
+	ass.Equal(t, `["z": ~P1D, "y": ~PT2H]`, fmt.Sprintf("%v", quotes))
+	bytes, _ = jsn.Marshal(quotes)
+	ass.Equal(t, `{"\"z\"":"~P1D","\"y\"":"~PT2H"}`, string(bytes))
+
+	var nested = fra.Catalog[string, any]()
+	err = jsn.Unmarshal([]byte(`{"list":["v1",2],"empty":{}}`), nested)
+	ass.Nil(t, err)
+	ass.Equal(t, "[v1, 2]", fmt.Sprintf("%v", nested.GetValue("list")))
+	ass.Equal(t, "[:]", fmt.Sprintf("%v", nested.GetValue("empty")))
+}
+
+func TestCodecElements(t *tes.T) {
+	var codec = fra.Codec()
+	var values = []any{
+		fra.AngleFromString("~π"),
+		fra.Boolean(true),
+		fra.DurationFromString("~P3DT4H"),
+		fra.Glyph('λ'),
+		fra.Moment(-62198755200000), // The year -1.
+		fra.NumberFromString("-1.5i"),
+		fra.NumberClass().Infinity(),
+		fra.NumberClass().Undefined(),
+		fra.PercentageFromString("50%"),
+		fra.ProbabilityFromString("p0.25"),
+		fra.ResourceFromString("<https://craterdog.com/about.html>"),
+		fra.SymbolFromString("$foo"),
+		fra.Binary([]byte{0, 1, 2, 254, 255}),
+		fra.NameFromString("/bali/types/Moment"),
+		fra.NarrativeFromString("\">\n    Hello\n<\""),
+		fra.PatternFromString(`"ab+c"?`),
+		fra.QuoteFromString(`"Hello \"World\""`),
+		fra.TagFromString("#ABCD"),
+		fra.VersionFromString("v1.2.3"),
+		"string",
+		42,
+		3.14,
+		false,
+	}
+	for _, value := range values {
+		var bytes = codec.Encode(value)
+		var decoded, err = codec.Decode(bytes)
+		ass.Nil(t, err)
+		ass.Equal(t, fmt.Sprintf("%v", value), fmt.Sprintf("%v", decoded))
+		ass.Equal(t, bytes, codec.Encode(decoded))
+	}
+	ass.Equal(
+		t,
+		codec.Encode(fra.NumberClass().Undefined()),
+		codec.Encode(fra.Number(complex(0, mat.NaN()))),
+	)
+}
+
+func TestCodecCollections(t *tes.T) {
+	var codec = fra.Codec()
+	var catalog = fra.CatalogFromString[any, any](
+		`["b": [1, [$x, $y]], "a": <2024-03-02T12:30>, "c": [:]]`,
+	)
+	var bytes = codec.Encode(catalog)
+	var decoded, err = codec.Decode(bytes)
+	ass.Nil(t, err)
+	ass.Equal(t, fmt.Sprintf("%v", catalog), fmt.Sprintf("%v", decoded))
+	ass.Equal(t, bytes, codec.Encode(decoded))
+
+	var set = fra.SetFromString[fra.VersionLike]("[v2, v1.2, v1]")
+	bytes = codec.Encode(set)
+	decoded, _ = codec.Decode(bytes)
+	ass.Equal(t, "[v1, v1.2, v2]", fmt.Sprintf("%v", decoded))
+	ass.Equal(t, 3, int(decoded.(fra.SetLike[any]).GetSize()))
+
+	var stack = fra.StackFromString[fra.SymbolLike]("[$top, $bottom]")
+	decoded, _ = codec.Decode(codec.Encode(stack))
+	ass.Equal(t, "$top", fmt.Sprintf("%v", decoded.(fra.StackLike[any]).GetLast()))
+
+	var queue = fra.QueueFromString[fra.DurationLike]("[~P1D, ~P2D]")
+	decoded, _ = codec.Decode(codec.Encode(queue))
+	ass.Equal(t, "[~P1D, ~P2D]", fmt.Sprintf("%v", decoded))
+
+	var association = fra.Association[string, fra.TagLike]("key", fra.TagFromString("#ABCD"))
+	decoded, _ = codec.Decode(codec.Encode(association))
+	ass.Equal(t, "#ABCD", fmt.Sprintf("%v", decoded.(fra.AssociationLike[any, any]).GetValue()))
+}
+
+func TestCodecErrors(t *tes.T) {
+	var codec = fra.Codec()
+	var bytes = codec.Encode(fra.ListFromString[any]("[1, 2, 3]"))
+	var _, err = codec.Decode(bytes[:len(bytes)-1])
+	ass.NotNil(t, err)
+	_, err = codec.Decode(append(bytes, 0))
+	ass.NotNil(t, err)
+	_, err = codec.Decode([]byte{0xFF})
+	ass.NotNil(t, err)
+	_, err = codec.Decode([]byte{})
+	ass.NotNil(t, err)
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "The codec cannot encode a value of type uint8: 5", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	codec.Encode(byte(5))
+}
+
+func TestCodecCanonicalForm(t *tes.T) {
+	var codec = fra.Codec()
+	var encoder = fra.Encoder()
+
+	// A catalog containing a duplicate key is rejected.
+	var catalog = fra.CatalogFromString[any, any](`["a": 1, "b": 2]`)
+	var tree, err = encoder.Decode(codec.Encode(catalog))
+	ass.Nil(t, err)
+	var node = tree.(fra.Tagged)
+	node.Fields[2] = node.Fields[0]
+	_, err = codec.Decode(encoder.Encode(node))
+	ass.NotNil(t, err)
+
+	// A set whose values are out of order is rejected.
+	var set = fra.SetFromString[any]("[1, 2]")
+	tree, _ = encoder.Decode(codec.Encode(set))
+	node = tree.(fra.Tagged)
+	node.Fields[0], node.Fields[1] = node.Fields[1], node.Fields[0]
+	_, err = codec.Decode(encoder.Encode(node))
+	ass.NotNil(t, err)
+}
+
+func TestEncoderCanonicalForm(t *tes.T) {
+	var encoder = fra.Encoder()
+	var tree = fra.Tagged{
+		Tag:    0x40,
+		Fields: []any{true, -42, 3.5, "abc", fra.Tagged{Tag: 0x41, Fields: []any{}}},
+	}
+	var bytes = encoder.Encode(tree)
+	var decoded, err = encoder.Decode(bytes)
+	ass.Nil(t, err)
+	ass.Equal(t, tree, decoded)
+	ass.Equal(t, encoder.Encode(mat.NaN()), encoder.Encode(-mat.NaN()))
+
+	// A non-minimal integer is rejected.
+	_, err = encoder.Decode([]byte{0x33, 0x80, 0x00})
+	ass.NotNil(t, err)
+
+	// A size that exceeds the remaining bytes is rejected.
+	_, err = encoder.Decode([]byte{0x40, 0x05, 0x33})
+	ass.NotNil(t, err)
+
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "The type tag 51 is reserved for the intrinsic types.", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	encoder.Encode(fra.Tagged{Tag: 0x33})
+}
+
+func TestMomentTimeZones(t *tes.T) {
+	var moment = fra.MomentFromString("<2024-03-01T12:00-05:00>")
+	ass.Equal(t, "<2024-03-01T17>", moment.AsString())
+	ass.Equal(t, moment, fra.MomentFromString("<2024-03-01T17:00Z>"))
+	ass.Equal(t, moment, fra.MomentFromString("<2024-03-01T22:30+05:30>"))
+
+	var zone, err = tim.LoadLocation("America/New_York")
+	ass.Nil(t, err)
+	ass.Equal(t, "<2024-03-01T12:00-05:00>", moment.AsZonedString(zone))
+	ass.Equal(t, "<2024-03-01T17>", moment.AsZonedString(tim.UTC))
+	ass.Equal(t, moment, fra.MomentFromTime(moment.AsTime()))
+
+	var tokyo, _ = tim.LoadLocation("Asia/Tokyo")
+	ass.Equal(t, "<2024-03-02T02:00+09:00>", moment.AsZonedString(tokyo))
+
+	var _, failure = fra.ParseMoment("<2024-03-01-05:00>")
+	ass.NotNil(t, failure)
+}
+
+func TestMomentCalendarArithmetic(t *tes.T) {
+	var class = fra.MomentClass()
+	var zone, _ = tim.LoadLocation("America/New_York")
+
+	// Daylight saving time started at 2am on March 10th, 2024 in New York.
+	var moment = fra.MomentFromString("<2024-03-09T12:00-05:00>")
+	var later = class.CalendarLater(moment, 0, 0, 1, zone)
+	ass.Equal(t, "<2024-03-10T12:00-04:00>", later.AsZonedString(zone))
+	ass.Equal(t, 23.0, class.Duration(moment, later).AsHours())
+	ass.Equal(t, moment, class.CalendarEarlier(later, 0, 0, 1, zone))
+
+	// The end of a month is clamped to the last day of the resulting month.
+	moment = fra.MomentFromString("<2024-01-31>")
+	ass.Equal(t, "<2024-02-29>", class.CalendarLater(moment, 0, 1, 0, tim.UTC).AsString())
+	ass.Equal(t, "<2025-02-28>", class.CalendarLater(moment, 1, 1, 0, tim.UTC).AsString())
+	ass.Equal(t, "<2023-11-30>", class.CalendarEarlier(moment, 0, 2, 0, tim.UTC).AsString())
+	ass.Equal(t, "<2023-12-31>", class.CalendarLater(moment, 0, -1, 0, tim.UTC).AsString())
+
+	// Negative years are supported as well.
+	moment = fra.MomentFromString("<-100-03-01>")
+	ass.Equal(t, "<-99-03>", class.CalendarLater(moment, 1, 0, 0, tim.UTC).AsString())
+}
+
+func TestInstants(t *tes.T) {
+	var instant = fra.InstantFromString("<2024-03-01T12:00:00.123456789>")
+	ass.Equal(t, "<2024-03-01T12:00:00.123456789>", instant.AsString())
+	ass.Equal(t, 123, int(instant.GetMilliseconds()))
+	ass.Equal(t, 456, int(instant.GetMicroseconds()))
+	ass.Equal(t, 789, int(instant.GetNanoseconds()))
+	ass.Equal(t, "<2024-03-01T12:00:00.123>", instant.AsMoment().AsString())
+
+	var time = tim.Date(1776, 7, 4, 12, 0, 0, 1, tim.UTC)
+	ass.Equal(t, time, fra.InstantFromTime(time).AsTime())
+	ass.Equal(t, "<1776-07-04T12:00:00.000000001>", fra.InstantFromTime(time).AsString())
+
+	var moment = fra.MomentFromString("<-1-02-03T04:05:06.700>")
+	ass.Equal(t, "<-1-02-03T04:05:06.700>", fra.InstantFromMoment(moment).AsString())
+	ass.Equal(t, moment, fra.InstantFromMoment(moment).AsMoment())
+
+	var zone, _ = tim.LoadLocation("America/New_York")
+	instant = fra.InstantFromString("<2024-03-01T12:00:00.000001-05:00>")
+	ass.Equal(t, "<2024-03-01T12:00:00.000001-05:00>", instant.AsZonedString(zone))
+
+	var class = fra.InstantClass()
+	var span = fra.SpanFromString("~PT0.000000001S")
+	var later = class.Later(instant, span)
+	ass.Equal(t, "<2024-03-01T17:00:00.000001001>", later.AsString())
+	ass.Equal(t, span, class.Span(instant, later))
+	ass.True(t, class.Span(later, instant).IsNegative())
+	ass.Equal(t, instant, class.Earlier(later, span))
+
+	var _, err = fra.ParseInstant("<2024-02-30T12:00:00.1234>")
+	ass.Equal(t, "Instant", err.(*fra.ParseError).Class)
+}
+
+func TestSpans(t *tes.T) {
+	var span = fra.SpanFromString("~P3DT4H5M6.123456789S")
+	ass.Equal(t, "~P3DT4H5M6.123456789S", span.AsString())
+	ass.Equal(t, 3, int(span.GetDays()))
+	ass.Equal(t, 123, int(span.GetMilliseconds()))
+	ass.Equal(t, 456, int(span.GetMicroseconds()))
+	ass.Equal(t, 789, int(span.GetNanoseconds()))
+	ass.Equal(t, "~P3DT4H5M6.123S", span.AsDuration().AsString())
+
+	var duration = 90*tim.Minute + 5*tim.Microsecond
+	ass.Equal(t, duration, fra.Span(duration).AsIntrinsic())
+	ass.Equal(t, "~PT1H30M0.000005S", fra.Span(duration).AsString())
+	ass.Equal(t, "~-P2W", fra.Span(-14*24*tim.Hour).AsString())
+	ass.Equal(t, "~P0W", fra.Span(0).AsString())
+	ass.True(t, fra.SpanFromString("~-PT1S").IsNegative())
+
+	var milliseconds = fra.DurationFromString("~PT0.05S")
+	ass.Equal(t, 50, milliseconds.AsInteger())
+	ass.Equal(t, "~PT0.05S", milliseconds.AsString())
+	ass.Equal(t, 50*tim.Millisecond, fra.SpanFromDuration(milliseconds).AsIntrinsic())
+
+	var _, err = fra.ParseSpan("~PT1.5")
+	ass.Equal(t, "Span", err.(*fra.ParseError).Class)
+}
+
+func TestPreciseLiterals(t *tes.T) {
+	var literal, _, _ = fra.ParseLiteral("<2024-03-01T12:00:00.1234>")
+	ass.Equal(t, "<2024-03-01T12:00:00.123400>", fmt.Sprintf("%v", literal))
+	var _, ok = literal.(fra.InstantLike)
+	ass.True(t, ok)
+
+	literal, _, _ = fra.ParseLiteral("<2024-03-01T12:00:00.123>")
+	_, ok = literal.(fra.MomentLike)
+	ass.True(t, ok)
+
+	literal, _, _ = fra.ParseLiteral("~PT0.0001S")
+	_, ok = literal.(fra.SpanLike)
+	ass.True(t, ok)
+
+	literal, _, _ = fra.ParseLiteral("~-P1D")
+	ass.Equal(t, "~-P1D", fmt.Sprintf("%v", literal))
+
+	// Only fractional seconds require the precision of a span.
+	literal, _, _ = fra.ParseLiteral("~P1.12345Y")
+	_, ok = literal.(fra.DurationLike)
+	ass.True(t, ok)
+	literal, _, _ = fra.ParseLiteral("~P1.12345YT2.5S")
+	_, ok = literal.(fra.DurationLike)
+	ass.True(t, ok)
+
+	var codec = fra.Codec()
+	var list = fra.ListFromString[any]("[<2024-03-01T12:00:00.1234>, ~PT0.0001S]")
+	var decoded, err = codec.Decode(codec.Encode(list))
+	ass.Nil(t, err)
+	ass.Equal(t, fmt.Sprintf("%v", list), fmt.Sprintf("%v", decoded))
+}
+
+func TestDurationAlgebra(t *tes.T) {
+	var class = fra.DurationClass()
+	var day = fra.DurationFromString("~P1D")
+	var hours = fra.DurationFromString("~PT6H")
+
+	var negative = class.Inverse(day)
+	ass.True(t, negative.IsNegative())
+	ass.Equal(t, "~-P1D", negative.AsString())
+	ass.Equal(t, negative, fra.DurationFromString("~-P1D"))
+	ass.Equal(t, 1, int(negative.GetDays()))
+	ass.Equal(t, -86400000, negative.AsIntrinsic())
+	ass.False(t, negative.IsMinimum())
+	ass.True(t, class.Difference(day, day).IsMinimum())
+
+	ass.Equal(t, "~P1DT6H", class.Sum(day, hours).AsString())
+	ass.Equal(t, "~PT18H", class.Difference(day, hours).AsString())
+	ass.Equal(t, "~-PT18H", class.Difference(hours, day).AsString())
+	ass.Equal(t, "~PT9H", class.Scaled(hours, 1.5).AsString())
+	ass.Equal(t, "~-PT3H", class.Scaled(hours, -0.5).AsString())
+	ass.Equal(t, 4.0, class.Ratio(day, hours))
+	ass.Equal(t, hours, class.Minimum(day, hours))
+	ass.Equal(t, negative, class.Minimum(hours, negative))
+	ass.Equal(t, day, class.Maximum(negative, day))
+
+	var moment = fra.MomentFromString("<2024-03-02>")
+	ass.Equal(t, "<2024-03>", fra.MomentClass().Later(moment, negative).AsString())
+
+	var _, err = fra.ParseDuration("~-PT1.5")
+	ass.Equal(t, "Duration", err.(*fra.ParseError).Class)
+}
+
+func TestDecimals(t *tes.T) {
+	var class = fra.DecimalClass()
+	var large = fra.DecimalFromString("12345678901234567890.123456789")
+	ass.Equal(t, "12345678901234567890.123456789", large.AsString())
+	ass.Equal(t, "1500", fra.DecimalFromString("1.5E3").AsString())
+	ass.Equal(t, "0.0015", fra.DecimalFromString("1.5E-3").AsString())
+	ass.Equal(t, "-2.5", fra.DecimalFromString("-2.50").AsString())
+	ass.Equal(t, class.Zero(), fra.DecimalFromString("-0.0"))
+	ass.Equal(t, "-1.25", fra.Decimal(big.NewInt(-125), 2).AsString())
+	ass.Equal(t, 2, fra.DecimalFromString("-1.25").GetScale())
+	ass.Equal(t, "-125", fra.DecimalFromString("-1.25").GetUnscaled().String())
+	ass.Equal(t, "0.1", fra.DecimalFromFloat(0.1).AsString())
+	ass.Equal(t, "42", fra.DecimalFromInteger(42).AsString())
+	ass.True(t, fra.DecimalFromString("-2.50").IsNegative())
+	ass.False(t, class.Undefined().IsDefined())
+	ass.Equal(t, "undefined", fra.DecimalFromString("undefined").AsString())
+
+	var _, err = fra.ParseDecimal("π")
+	ass.Equal(t, "Decimal", err.(*fra.ParseError).Class)
+	_, err = fra.ParseDecimal("1.5i")
+	ass.NotNil(t, err)
+
+	var number = fra.NumberFromString("-1.25")
+	ass.Equal(t, "-1.25", fra.DecimalFromNumber(number).AsString())
+	ass.Equal(t, number, fra.DecimalFromString("-1.25").AsNumber())
+	ass.False(t, fra.DecimalFromNumber(fra.NumberFromString("1+2i")).IsDefined())
+	ass.False(t, fra.DecimalFromNumber(fra.NumberClass().Infinity()).IsDefined())
+	ass.Equal(t, 0, big.NewRat(-5, 4).Cmp(fra.DecimalFromString("-1.25").AsIntrinsic()))
+
+	var bytes, _ = jsn.Marshal(large)
+	ass.Equal(t, `"12345678901234567890.123456789"`, string(bytes))
+	var codec = fra.Codec()
+	var decoded, _ = codec.Decode(codec.Encode(large))
+	ass.Equal(t, large, decoded)
+}
+
+func TestDecimalLibrary(t *tes.T) {
+	var class = fra.DecimalClass()
+	var tenth = fra.DecimalFromString("0.1")
+	var fifth = fra.DecimalFromString("0.2")
+	var three = fra.DecimalFromInteger(3)
+	var large = fra.DecimalFromString("99999999999999999999")
+
+	ass.Equal(t, "0.3", class.Sum(tenth, fifth).AsString())
+	ass.Equal(t, "100000000000000000000", class.Sum(large, fra.DecimalFromInteger(1)).AsString())
+	ass.Equal(t, "-0.1", class.Difference(tenth, fifth).AsString())
+	ass.Equal(t, "-0.1", class.Inverse(tenth).AsString())
+	ass.Equal(t, tenth, class.Inverse(class.Inverse(tenth)))
+	ass.Equal(t, "0.02", class.Product(tenth, fifth).AsString())
+	ass.Equal(t, "9999999999999999999800000000000000000001", class.Product(large, large).AsString())
+	ass.Equal(t, "0.15", class.Scaled(tenth, 1.5).AsString())
+
+	ass.Equal(t, "0.5", class.Quotient(tenth, fifth).AsString())
+	ass.Equal(t, "0.125", class.Quotient(fra.DecimalFromInteger(1), fra.DecimalFromInteger(8)).AsString())
+	ass.Equal(t, "0.3333333333333333333333333333333333", class.Reciprocal(three).AsString())
+	ass.Equal(t, "-6.666666666666666666666666666666667", class.Quotient(fra.DecimalFromInteger(-20), three).AsString())
+	ass.False(t, class.Quotient(tenth, class.Zero()).IsDefined())
+
+	ass.Equal(t, "0.1", class.Remainder(fra.DecimalFromString("1.3"), fifth).AsString())
+	ass.Equal(t, "-1", class.Remainder(fra.DecimalFromInteger(-7), three).AsString())
+	ass.False(t, class.Remainder(three, class.Zero()).IsDefined())
+
+	ass.Equal(t, "0.001", class.Power(tenth, three).AsString())
+	ass.Equal(t, "1000", class.Power(tenth, fra.DecimalFromInteger(-3)).AsString())
+	ass.Equal(t, class.One(), class.Power(class.Zero(), class.Zero()))
+	ass.False(t, class.Power(three, tenth).IsDefined())
+	ass.False(t, class.Sum(tenth, class.Undefined()).IsDefined())
+}
+
+func TestDecimalRange(t *tes.T) {
+	var class = fra.DecimalClass()
+	ass.Equal(t, 6144, int(class.MaximumExponent()))
+
+	// Literals whose exponents are out of range are rejected.
+	var decimal, err = fra.ParseDecimal("1E6143")
+	ass.Nil(t, err)
+	ass.Equal(t, 6144, len(decimal.AsString()))
+	for _, source := range []string{"1E6144", "1E-6145", "1E999999999", "1E-99999999999999999999"} {
+		decimal, err = fra.ParseDecimal(source)
+		ass.Nil(t, decimal)
+		ass.Equal(t, "Decimal", err.(*fra.ParseError).Class)
+		ass.Equal(t, 1, int(err.(*fra.ParseError).Offset))
+	}
+
+	// Integer literals with too many digits are also out of range.
+	var digits = "-1" + sts.Repeat("0", 6144)
+	decimal, err = fra.ParseDecimal(digits)
+	ass.Nil(t, decimal)
+	ass.Equal(t, "Decimal", err.(*fra.ParseError).Class)
+	ass.Equal(t, 1, int(err.(*fra.ParseError).Offset))
+
+	// Results that are out of range are undefined.
+	var two = fra.DecimalFromInteger(2)
+	var huge = fra.DecimalFromInteger(999999999999)
+	ass.False(t, class.Power(two, huge).IsDefined())
+	ass.False(t, class.Power(fra.DecimalFromString("0.5"), huge).IsDefined())
+	ass.Equal(t, class.One(), class.Power(class.One(), huge))
+	ass.Equal(t, "1024", class.Power(two, fra.DecimalFromInteger(10)).AsString())
+	var large = fra.DecimalFromString("1E4000")
+	ass.False(t, class.Product(large, large).IsDefined())
+	ass.False(t, class.Decimal(big.NewInt(1), -6144).IsDefined())
+
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "An illegal string was passed to the decimal constructor method: 1E6144", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	fra.DecimalFromString("1E6144") // Should panic here.
+}
+
+func TestRationals(t *tes.T) {
+	var class = fra.RationalClass()
+	var third = fra.RationalFromString("2/6")
+	ass.Equal(t, "1/3", third.AsString())
+	ass.Equal(t, "-22/7", fra.RationalFromFraction(22, -7).AsString())
+	ass.Equal(t, "5/1", fra.RationalFromInteger(5).AsString())
+	ass.Equal(t, "1/10", fra.RationalFromFloat(0.1).AsString())
+	ass.Equal(t, "-5/4", fra.RationalFromDecimal(fra.DecimalFromString("-1.25")).AsString())
+	ass.Equal(t, "0.3333333333333333333333333333333333", third.AsDecimal().AsString())
+	ass.Equal(t, "3", third.GetDenominator().String())
+	ass.Equal(t, 1.0/3.0, third.AsFloat())
+	ass.Equal(t, fra.Number(0.25), fra.RationalFromString("1/4").AsNumber())
+	ass.True(t, fra.RationalFromString("-1/4").IsNegative())
+	ass.Equal(t, class.Zero(), fra.RationalFromString("-0/5"))
+	ass.False(t, fra.RationalFromFraction(1, 0).IsDefined())
+	ass.Equal(t, 0, big.NewRat(1, 3).Cmp(third.AsIntrinsic()))
+
+	var _, err = fra.ParseRational("1/0")
+	ass.Equal(t, "Rational", err.(*fra.ParseError).Class)
+
+	var element, length = fra.ElementParser().ParseElement("3/7]")
+	ass.Equal(t, fra.RationalFromFraction(3, 7), element)
+	ass.Equal(t, 3, int(length))
+
+	var bytes, _ = jsn.Marshal(third)
+	ass.Equal(t, `"1/3"`, string(bytes))
+	var codec = fra.Codec()
+	var decoded, _ = codec.Decode(codec.Encode(third))
+	ass.Equal(t, third, decoded)
+}
+
+func TestRationalLibrary(t *tes.T) {
+	var class = fra.RationalClass()
+	var third = fra.RationalFromFraction(1, 3)
+	var half = fra.RationalFromFraction(1, 2)
+	var two = fra.RationalFromInteger(2)
+
+	ass.Equal(t, "5/6", class.Sum(third, half).AsString())
+	ass.Equal(t, "-1/6", class.Difference(third, half).AsString())
+	ass.Equal(t, "-1/3", class.Inverse(third).AsString())
+	ass.Equal(t, "3/1", class.Reciprocal(third).AsString())
+	ass.Equal(t, "1/6", class.Product(third, half).AsString())
+	ass.Equal(t, "2/3", class.Quotient(third, half).AsString())
+	ass.Equal(t, "1/2", class.Scaled(third, 1.5).AsString())
+	ass.Equal(t, "1/6", class.Remainder(half, third).AsString())
+	ass.Equal(t, "-1/2", class.Remainder(fra.RationalFromString("-5/2"), two).AsString())
+	ass.Equal(t, "1/8", class.Power(half, fra.RationalFromInteger(3)).AsString())
+	ass.Equal(t, "9/1", class.Power(third, fra.RationalFromInteger(-2)).AsString())
+	ass.False(t, class.Power(two, half).IsDefined())
+	ass.False(t, class.Quotient(half, class.Zero()).IsDefined())
+	ass.False(t, class.Reciprocal(class.Zero()).IsDefined())
+
+	// Repeated arithmetic never drifts.
+	var sum = class.Zero()
+	var tenth = fra.RationalFromFraction(1, 10)
+	for count := 0; count < 10; count++ {
+		sum = class.Sum(sum, tenth)
+	}
+	ass.Equal(t, class.One(), sum)
+}
+
+func TestRationalRanking(t *tes.T) {
+	var third = fra.RationalFromFraction(1, 3)
+	var rationals = fra.Collator[fra.RationalLike]()
+	ass.Equal(t, fra.LesserRank, rationals.RankValues(fra.RationalFromFraction(-1, 2), third))
+	ass.Equal(t, fra.GreaterRank, rationals.RankValues(third, fra.RationalFromFraction(1, 4)))
+	ass.Equal(t, fra.LesserRank, rationals.RankValues(fra.RationalClass().Undefined(), third))
+
+	var values = fra.ListFromArray[any]([]any{
+		fra.Number(0.5),
+		third,
+		fra.DecimalFromString("0.3333"),
+		fra.NumberClass().Maximum(),
+		fra.RationalFromFraction(-7, 2),
+		fra.Probability(0.25),
+	})
+	values.SortValues()
+	ass.Equal(t, "[-7/2, p0.25, 0.3333, 1/3, 0.5, +∞]", fmt.Sprintf("%v", values))
+
+	var continuum, err = fra.ParseContinuum[fra.RationalLike]("[1/3..1/2)")
+	ass.Nil(t, err)
+	ass.True(t, continuum.ContainsValue(fra.RationalFromFraction(2, 5)))
+	ass.False(t, continuum.ContainsValue(fra.RationalFromFraction(3, 5)))
+	_, err = fra.ParseContinuum[fra.RationalLike]("[1/2..1/3]")
+	ass.NotNil(t, err)
+}
+
+func TestNumericRankingIsTransitive(t *tes.T) {
+	var collator = fra.Collator[any]()
+	var number = fra.Number(0.3)
+	var probability = fra.Probability(0.25)
+	var rational = fra.RationalFromFraction(27, 100)
+	ass.Equal(t, fra.LesserRank, collator.RankValues(probability, rational))
+	ass.Equal(t, fra.LesserRank, collator.RankValues(rational, number))
+	ass.Equal(t, fra.LesserRank, collator.RankValues(probability, number))
+
+	// Every triple of values must be ranked consistently.
+	var values = []any{
+		number,
+		probability,
+		rational,
+		fra.Number(-2),
+		fra.Number(complex(0.25, 1)),
+		fra.NumberClass().Maximum(),
+		fra.NumberClass().Undefined(),
+		fra.Probability(0.5),
+		fra.Probability(0.3),
+		fra.RationalFromFraction(1, 4),
+		fra.RationalFromFraction(-7, 2),
+		fra.DecimalFromString("0.3"),
+		fra.DecimalFromString("0.25"),
+		fra.Angle(0.3),
+		fra.Percentage(0.27),
+		fra.QuantityFromString("0.3 m"),
+		"0.3",
+		3,
+	}
+	for _, first := range values {
+		for _, second := range values {
+			var rank = collator.RankValues(first, second)
+			ass.Equal(t, fra.GreaterRank-rank, collator.RankValues(second, first), "%v and %v", first, second)
+			for _, third := range values {
+				if rank == fra.LesserRank && collator.RankValues(second, third) == fra.LesserRank {
+					ass.Equal(t, fra.LesserRank, collator.RankValues(first, third), "%v, %v and %v", first, second, third)
+				}
+			}
+		}
+	}
+}
+
+func TestQuantities(t *tes.T) {
+	var gravity = fra.QuantityFromString("9.81 m/s^2")
+	ass.Equal(t, "9.81 m/s^2", gravity.AsString())
+	ass.Equal(t, fra.Number(9.81), gravity.GetMagnitude())
+	ass.Equal(t, "m/s^2", gravity.GetUnits())
+	ass.Equal(t, "m/s^2", gravity.GetDimensions())
+	ass.Equal(t, "m·kg/s^2", fra.QuantityFromString("1 N").GetDimensions())
+	ass.Equal(t, "m^2·kg/s^3/A", fra.QuantityFromString("5 kV").GetDimensions())
+	ass.Equal(t, "3 kg·m/s^2", fra.Quantity(fra.Number(3), "kg*m/s/s").AsString())
+	ass.Equal(t, "2 s^-1", fra.QuantityFromString("2 s^-1").AsString())
+	ass.Equal(t, "42", fra.QuantityFromString("42").AsString())
+	ass.Equal(t, 1500.0, fra.QuantityFromString("1.5 km").AsFloat())
+	ass.True(t, fra.QuantityFromString("-3 µm").IsNegative())
+
+	var _, err = fra.ParseQuantity("5 furlongs")
+	ass.Equal(t, "Quantity", err.(*fra.ParseError).Class)
+	ass.Equal(t, 2, int(err.(*fra.ParseError).Offset))
+	_, err = fra.ParseQuantity("5  m")
+	ass.NotNil(t, err)
+
+	var bytes, _ = jsn.Marshal(gravity)
+	ass.Equal(t, `"9.81 m/s^2"`, string(bytes))
+	var codec = fra.Codec()
+	var decoded, _ = codec.Decode(codec.Encode(gravity))
+	ass.Equal(t, gravity, decoded)
+}
+
+func TestQuantityLibrary(t *tes.T) {
+	var class = fra.QuantityClass()
+	var kilometer = fra.QuantityFromString("1 km")
+	var meters = fra.QuantityFromString("500 m")
+	var seconds = fra.QuantityFromString("20 s")
+
+	ass.Equal(t, "1.5 km", class.Sum(kilometer, meters).AsString())
+	ass.Equal(t, "-500 m", class.Difference(meters, kilometer).AsString())
+	ass.Equal(t, "-1 km", class.Inverse(kilometer).AsString())
+	ass.Equal(t, "1000 m", class.Scaled(meters, 2).AsString())
+	ass.Equal(t, "25 m/s", class.Quotient(meters, seconds).AsString())
+	ass.Equal(t, "500 km·m", class.Product(kilometer, meters).AsString())
+	ass.Equal(t, "250000 m^2", class.Product(meters, meters).AsString())
+	ass.Equal(t, "0.5", class.Quotient(meters, fra.QuantityFromString("1000 m")).AsString())
+	ass.Equal(t, "1000 m", kilometer.AsUnits("m").AsString())
+	ass.Equal(t, "90 km/h", fra.QuantityFromString("25 m/s").AsUnits("km/h").AsString())
+	ass.Equal(t, "1 kg·m^2/s^2", fra.QuantityFromString("1 J").AsUnits("kg·m^2/s^2").AsString())
+
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "Quantities with different dimensions cannot be added: m and s", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	class.Sum(meters, seconds)
+}
+
+func TestQuantityRanking(t *tes.T) {
+	var collator = fra.Collator[fra.QuantityLike]()
+	var kilometer = fra.QuantityFromString("1 km")
+	ass.Equal(t, fra.GreaterRank, collator.RankValues(kilometer, fra.QuantityFromString("999 m")))
+	ass.Equal(t, fra.LesserRank, collator.RankValues(fra.QuantityFromString("-2 km"), fra.QuantityFromString("1 m")))
+	ass.NotEqual(t, fra.EqualRank, collator.RankValues(fra.QuantityFromString("1 m"), fra.QuantityFromString("1 s")))
+
+	// Quantities with different dimensions are ranked by their types and structures.
+	ass.Equal(t, fra.LesserRank, collator.RankValues(fra.QuantityFromString("1 m"), fra.QuantityFromString("1 s")))
+	ass.Equal(t, fra.GreaterRank, collator.RankValues(fra.QuantityFromString("2 s"), fra.QuantityFromString("1 km")))
+
+	// Quantities are never ranked numerically against plain numbers.
+	var values = fra.Collator[any]()
+	var number = fra.Number(5)
+	ass.Equal(t, values.RankValues(fra.QuantityFromString("1 m"), number), values.RankValues(fra.QuantityFromString("9 m"), number))
+	ass.Equal(t, values.RankValues(number, fra.QuantityFromString("1 m")), values.RankValues(number, fra.QuantityFromString("9 m")))
+
+	var distances = fra.Continuum[fra.QuantityLike](
+		fra.Inclusive,
+		fra.QuantityFromString("500 m"),
+		fra.QuantityFromString("2 km"),
+		fra.Inclusive,
+	)
+	ass.Equal(t, "[500 m..2 km]", fmt.Sprintf("%v", distances))
+	ass.True(t, distances.ContainsValue(kilometer))
+	ass.False(t, distances.ContainsValue(fra.QuantityFromString("300 m")))
+}
+
+func TestMonies(t *tes.T) {
+	var price = fra.MoneyFromString("12.34 USD")
+	ass.Equal(t, "12.34 USD", price.AsString())
+	ass.Equal(t, "USD", price.GetCurrency())
+	ass.Equal(t, int64(1234), price.GetMinorUnits())
+	ass.Equal(t, "12.34", price.AsDecimal().AsString())
+	ass.False(t, price.IsNegative())
+	ass.Equal(t, "12.50 EUR", fra.MoneyFromString("12.5 EUR").AsString())
+	ass.Equal(t, "-0.05 GBP", fra.Money("GBP", -5).AsString())
+	ass.Equal(t, "1500 JPY", fra.MoneyFromString("1500 JPY").AsString())
+	ass.Equal(t, "1.000 KWD", fra.Money("KWD", 1000).AsString())
+	ass.Equal(t, "2.68 USD", fra.MoneyFromDecimal("USD", fra.DecimalFromString("2.675")).AsString())
+	ass.Equal(t, "2.66 USD", fra.MoneyFromDecimal("USD", fra.DecimalFromString("2.665")).AsString())
+	ass.True(t, fra.Money("USD", 0).IsZero())
+	ass.Equal(t, price, fra.Money("USD", 1234))
+
+	var _, err = fra.ParseMoney("12.345 USD")
+	ass.Equal(t, "Money", err.(*fra.ParseError).Class)
+	ass.Equal(t, 5, int(err.(*fra.ParseError).Offset))
+	_, err = fra.ParseMoney("12.34 XYZ")
+	ass.Equal(t, 6, int(err.(*fra.ParseError).Offset))
+	_, err = fra.ParseMoney("$12.34")
+	ass.NotNil(t, err)
+
+	var bytes, _ = jsn.Marshal(price)
+	ass.Equal(t, `"12.34 USD"`, string(bytes))
+	var codec = fra.Codec()
+	var decoded, _ = codec.Decode(codec.Encode(price))
+	ass.Equal(t, price, decoded)
+
+	var collator = fra.Collator[fra.MoneyLike]()
+	ass.Equal(t, fra.LesserRank, collator.RankValues(fra.Money("USD", -100), fra.Money("USD", 5)))
+	ass.NotEqual(t, fra.EqualRank, collator.RankValues(fra.Money("USD", 100), fra.Money("EUR", 100)))
+}
+
+func TestMoneyLibrary(t *tes.T) {
+	var class = fra.MoneyClass()
+	var price = fra.MoneyFromString("19.99 USD")
+	var discount = fra.MoneyFromString("5.00 USD")
+
+	ass.Equal(t, "24.99 USD", class.Sum(price, discount).AsString())
+	ass.Equal(t, "-14.99 USD", class.Difference(discount, price).AsString())
+	ass.Equal(t, "-19.99 USD", class.Inverse(price).AsString())
+	ass.Equal(t, "59.97 USD", class.Scaled(price, 3).AsString())
+	ass.Equal(t, "2.20 USD", class.Scaled(fra.MoneyFromString("2.00 USD"), 1.1).AsString())
+	ass.Equal(t, "0.08 USD", class.Percentage(fra.MoneyFromString("1.00 USD"), fra.Percentage(7.5)).AsString())
+	ass.Equal(t, "1.50 USD", class.Percentage(price, fra.Percentage(7.5)).AsString())
+
+	var shares = class.Split(fra.MoneyFromString("100.00 USD"), 3)
+	ass.Equal(t, "[33.34 USD 33.33 USD 33.33 USD]", fmt.Sprintf("%v", shares))
+	shares = class.Split(fra.MoneyFromString("-0.05 EUR"), 3)
+	ass.Equal(t, "[-0.02 EUR -0.02 EUR -0.01 EUR]", fmt.Sprintf("%v", shares))
+	shares = class.Allocate(fra.MoneyFromString("0.05 USD"), []uint{3, 0, 7})
+	ass.Equal(t, "[0.02 USD 0.00 USD 0.03 USD]", fmt.Sprintf("%v", shares))
+
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "Amounts in different currencies cannot be added: USD and EUR", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	class.Sum(price, fra.MoneyFromString("5.00 EUR"))
+}
+
+func TestPersistentLists(t *tes.T) {
+	var empty = fra.PersistentList[string]()
+	ass.True(t, empty.IsEmpty())
+	ass.Equal(t, "[]", fmt.Sprintf("%v", empty))
+
+	var first = empty.AppendValue("foo")
+	var second = first.AppendValues(fra.ListFromArray([]string{"bar", "baz"}))
+	var third = second.InsertValue(1, "qux").SetValue(-1, "bax").RemoveValue(3)
+	ass.True(t, empty.IsEmpty())
+	ass.Equal(t, []string{"foo"}, first.AsArray())
+	ass.Equal(t, []string{"foo", "bar", "baz"}, second.AsArray())
+	ass.Equal(t, []string{"foo", "qux", "bax"}, third.AsArray())
+	ass.Equal(t, "qux", third.GetValue(2))
+	ass.Equal(t, 3, third.GetIndex("bax"))
+	ass.True(t, third.ContainsAll(fra.ListFromArray([]string{"foo", "bax"})))
+	ass.False(t, third.ContainsAny(fra.ListFromArray([]string{"bar", "baz"})))
+	ass.Equal(t, []string{"qux", "bax"}, third.GetValues(2, 3).AsArray())
+	ass.Equal(t, []string{"foo"}, third.RemoveValues(2, -1).AsArray())
+	ass.Equal(t, "[foo, qux, bax]", fmt.Sprintf("%v", third))
+	var bytes, _ = jsn.Marshal(third)
+	ass.Equal(t, `["foo","qux","bax"]`, string(bytes))
+	var list = fra.ListFromSequence[string](third)
+	list.AppendValue("last")
+	ass.Equal(t, uint(3), third.GetSize())
+
+	var class = fra.PersistentListClass[string]()
+	var combined = class.Concatenate(second, third)
+	ass.Equal(t, []string{"foo", "bar", "baz", "foo", "qux", "bax"}, combined.AsArray())
+	ass.Equal(t, []string{"foo", "x", "y", "bar", "baz"}, second.InsertValues(
+		1, fra.ListFromArray([]string{"x", "y"}),
+	).AsArray())
+	ass.Equal(t, `["a", "b"]`, fmt.Sprintf("%v", fra.PersistentListFromString[any](`["a", "b"]`)))
+
+	// Compare a sequence of versions with a mutable list.
+	var versions = []fra.PersistentListLike[int]{fra.PersistentList[int]()}
+	var arrays = [][]int{{}}
+	var mutable = fra.List[int]()
+	for index := 0; index < 500; index++ {
+		var latest = versions[len(versions)-1]
+		var size = latest.GetSize()
+		var next fra.PersistentListLike[int]
+		switch {
+		case index%5 == 4 && size > 0:
+			var position = (index*7)%int(size) + 1
+			next = latest.RemoveValue(position)
+			mutable.RemoveValue(position)
+		case index%5 == 3 && size > 0:
+			var position = (index*11)%int(size) + 1
+			next = latest.SetValue(position, -index)
+			mutable.SetValue(position, -index)
+		default:
+			var slot = uint(index*13) % (size + 1)
+			next = latest.InsertValue(slot, index)
+			mutable.InsertValue(slot, index)
+		}
+		versions = append(versions, next)
+		arrays = append(arrays, mutable.AsArray())
+	}
+	for index, version := range versions {
+		ass.Equal(t, uint(len(arrays[index])), version.GetSize())
+		ass.Equal(t, arrays[index], append([]int{}, version.AsArray()...))
+	}
+}
+
+func TestPersistentSets(t *tes.T) {
+	var empty = fra.PersistentSet[string]()
+	var first = empty.AddValues(fra.ListFromArray([]string{"foo", "bar", "baz", "bar"}))
+	var second = first.RemoveValue("bar").AddValue("qux")
+	ass.True(t, empty.IsEmpty())
+	ass.Equal(t, []string{"bar", "baz", "foo"}, first.AsArray())
+	ass.Equal(t, []string{"baz", "foo", "qux"}, second.AsArray())
+	ass.Equal(t, first, first.AddValue("foo"))
+	ass.Equal(t, first, first.RemoveValue("missing"))
+	ass.Equal(t, 2, second.GetIndex("foo"))
+	ass.Equal(t, 0, second.GetIndex("bar"))
+	ass.Equal(t, "qux", second.GetValue(-1))
+	ass.Equal(t, []string{"foo", "qux"}, second.GetValues(2, 3).AsArray())
+	ass.True(t, second.ContainsValue("baz"))
+	ass.False(t, second.ContainsAll(first))
+	ass.True(t, second.ContainsAny(first))
+	ass.Equal(t, "[baz, foo, qux]", fmt.Sprintf("%v", second))
+	ass.Equal(t, `["a", "b"]`, fmt.Sprintf("%v", fra.PersistentSetFromString[any](`["b", "a", "b"]`)))
+
+	var class = fra.PersistentSetClass[string]()
+	ass.Equal(t, []string{"baz", "foo"}, class.And(first, second).AsArray())
+	ass.Equal(t, []string{"bar", "baz", "foo", "qux"}, class.Ior(first, second).AsArray())
+	ass.Equal(t, []string{"bar"}, class.San(first, second).AsArray())
+	ass.Equal(t, []string{"bar", "qux"}, class.Xor(first, second).AsArray())
+
+	// A snapshot may be searched by many go-routines at the same time.
+	var numbers = fra.PersistentSet[int]()
+	for index := 0; index < 1000; index++ {
+		numbers = numbers.AddValue((index * 389) % 1000)
+	}
+	var snapshot = numbers
+	numbers = numbers.RemoveValues(fra.ListFromArray([]int{1, 2, 3}))
+	var group syn.WaitGroup
+	for routine := 0; routine < 8; routine++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for value := 0; value < 1000; value++ {
+				ass.Equal(t, value+1, snapshot.GetIndex(value))
+			}
+		}()
+	}
+	group.Wait()
+	ass.Equal(t, uint(1000), snapshot.GetSize())
+	ass.Equal(t, uint(997), numbers.GetSize())
+	ass.Equal(t, 4, numbers.GetValue(2))
+}
+
+func TestPersistentCatalogs(t *tes.T) {
+	var empty = fra.PersistentCatalog[string, int]()
+	var first = empty.SetValue("foo", 1).SetValue("bar", 2).SetValue("baz", 3)
+	var second = first.SetValue("bar", 20).RemoveValue("foo").SetValue("foo", 10)
+	ass.True(t, empty.IsEmpty())
+	ass.Equal(t, "[foo: 1, bar: 2, baz: 3]", fmt.Sprintf("%v", first))
+	ass.Equal(t, "[bar: 20, baz: 3, foo: 10]", fmt.Sprintf("%v", second))
+	ass.Equal(t, 2, first.GetValue("bar"))
+	ass.Equal(t, 20, second.GetValue("bar"))
+	ass.Equal(t, 0, second.GetValue("missing"))
+	ass.Equal(t, first, first.RemoveValue("missing"))
+	ass.Equal(t, []string{"bar", "baz", "foo"}, second.GetKeys().AsArray())
+	ass.Equal(t, []int{10, 3}, second.GetValues(fra.ListFromArray([]string{"foo", "baz"})).AsArray())
+	ass.Equal(t, map[string]int{"bar": 20, "baz": 3, "foo": 10}, second.AsMap())
+	ass.Equal(t, uint(1), second.RemoveValues(fra.ListFromArray([]string{"foo", "bar"})).GetSize())
+
+	// Changing an association that was returned does not change the catalog.
+	second.AsArray()[0].SetValue(200)
+	ass.Equal(t, 20, second.GetValue("bar"))
+
+	var bytes, _ = jsn.Marshal(second)
+	ass.Equal(t, `{"bar":20,"baz":3,"foo":10}`, string(bytes))
+	var catalog = fra.CatalogFromSequence[string, int](second)
+	ass.Equal(t, 10, catalog.GetValue("foo"))
+	var codec = fra.Codec()
+	var decoded, _ = codec.Decode(codec.Encode(second))
+	ass.Equal(t, "[bar: 20, baz: 3, foo: 10]", fmt.Sprintf("%v", decoded))
+
+	var class = fra.PersistentCatalogClass[string, int]()
+	ass.Equal(t, "[foo: 10, bar: 20, baz: 3]", fmt.Sprintf("%v", class.Merge(first, second)))
+	ass.Equal(t, "[baz: 3, foo: 1]", fmt.Sprintf("%v", class.Extract(
+		first, fra.ListFromArray([]string{"baz", "foo"}),
+	)))
+	ass.Equal(t, "[a: 1, b: 2]", fmt.Sprintf("%v", fra.PersistentCatalogFromMap(
+		map[string]int{"b": 2, "a": 1},
+	)))
+	ass.Equal(t, `["a": 1, "b": 2]`, fmt.Sprintf("%v", fra.PersistentCatalogFromString[any, any](`["a": 1, "b": 2]`)))
+
+	// Many keys exercise the deeper levels of the hash trie.
+	var numbers = fra.PersistentCatalog[int, int]()
+	for key := 0; key < 5000; key++ {
+		numbers = numbers.SetValue(key, key*key)
+	}
+	var snapshot = numbers
+	for key := 0; key < 5000; key += 2 {
+		numbers = numbers.RemoveValue(key)
+	}
+	ass.Equal(t, uint(5000), snapshot.GetSize())
+	ass.Equal(t, uint(2500), numbers.GetSize())
+	for key := 0; key < 5000; key++ {
+		ass.Equal(t, key*key, snapshot.GetValue(key))
+		if key%2 == 0 {
+			ass.Equal(t, 0, numbers.GetValue(key))
+		} else {
+			ass.Equal(t, key*key, numbers.GetValue(key))
+		}
+	}
+	ass.Equal(t, 1, numbers.GetKeys().AsArray()[0])
+}
+
+func TestTreeSets(t *tes.T) {
+	var array = fra.ListFromArray([]int{3, 1, 4, 5, 9, 2})
+	var set = fra.TreeSet[int]()       // [ ]
+	ass.True(t, set.IsEmpty())         // [ ]
+	set.AddValues(array)               // [1,2,3,4,5,9]
+	ass.False(t, set.IsEmpty())        // [1,2,3,4,5,9]
+	ass.True(t, set.GetSize() == 6)    // [1,2,3,4,5,9]
+	ass.True(t, set.GetValue(1) == 1)  // [1,2,3,4,5,9]
+	ass.True(t, set.GetValue(-1) == 9) // [1,2,3,4,5,9]
+	set.RemoveValue(6)                 // [1,2,3,4,5,9]
+	ass.True(t, set.GetSize() == 6)    // [1,2,3,4,5,9]
+	set.RemoveValue(3)                 // [1,2,4,5,9]
+	ass.True(t, set.GetSize() == 5)    // [1,2,4,5,9]
+	ass.True(t, set.GetValue(3) == 4)  // [1,2,4,5,9]
+	ass.Equal(t, 4, set.GetIndex(5))   // [1,2,4,5,9]
+	ass.Equal(t, []int{2, 4}, set.GetValues(2, 3).AsArray())
+	ass.Equal(t, "[1, 2, 4, 5, 9]", fmt.Sprintf("%v", set))
+	var collator = fra.Collator[fra.SetLike[int]]()
+	ass.True(t, collator.CompareValues(set, fra.SetClass[int]().Ior(set, set)))
+	ass.Equal(t, set.AsArray(), fra.SetFromSequence[int](set).AsArray())
+
+	var bytes, _ = jsn.Marshal(set)
+	ass.Equal(t, "[1,2,4,5,9]", string(bytes))
+	var other = fra.TreeSet[int]()
+	jsn.Unmarshal([]byte("[9,7,7,1]"), other)
+	ass.Equal(t, []int{1, 7, 9}, other.AsArray())
+
+	var class = fra.SetClass[int]()
+	ass.Equal(t, []int{1, 9}, class.And(set, other).AsArray())
+	ass.Equal(t, []int{1, 2, 4, 5, 7, 9}, class.Ior(set, other).AsArray())
+	ass.Equal(t, []int{2, 4, 5}, class.San(set, other).AsArray())
+	ass.Equal(t, []int{2, 4, 5, 7}, class.Xor(set, other).AsArray())
+	set.RemoveAll()
+	ass.True(t, set.IsEmpty())
+
+	// A large set is loaded in O[n*log(n)] time.
+	var large = fra.TreeSetWithCollator(fra.Collator[int]())
+	for index := 0; index < 100000; index++ {
+		large.AddValue((index * 7919) % 100000)
+	}
+	ass.Equal(t, uint(100000), large.GetSize())
+	for index := 0; index < 100000; index += 2 {
+		large.RemoveValue(index)
+	}
+	ass.Equal(t, uint(50000), large.GetSize())
+	ass.Equal(t, 1, large.GetValue(1))
+	ass.Equal(t, 99999, large.GetValue(-1))
+	ass.Equal(t, 25000, large.GetIndex(49999))
+}
+
+func TestDictionaries(t *tes.T) {
+	var dictionary = fra.Dictionary[string, int]()
+	ass.True(t, dictionary.IsEmpty())
+	ass.Equal(t, "[:]", fmt.Sprintf("%v", dictionary))
+	ass.Nil(t, dictionary.GetFirst())
+	ass.Nil(t, dictionary.GetLast())
+	ass.Nil(t, dictionary.GetFloor("foo"))
+	ass.Nil(t, dictionary.GetCeiling("foo"))
+	dictionary.SetValue("foo", 1)
+	dictionary.SetValue("bar", 2)
+	dictionary.SetValue("qux", 3)
+	dictionary.SetValue("baz", 4)
+	dictionary.SetValue("foo", 10)
+	ass.Equal(t, uint(4), dictionary.GetSize())
+	ass.Equal(t, "[bar: 2, baz: 4, foo: 10, qux: 3]", fmt.Sprintf("%v", dictionary))
+	ass.Equal(t, []string{"bar", "baz", "foo", "qux"}, dictionary.GetKeys().AsArray())
+	ass.Equal(t, "bar", dictionary.GetFirst().GetKey())
+	ass.Equal(t, "qux", dictionary.GetLast().GetKey())
+	ass.Equal(t, 10, dictionary.GetValue("foo"))
+	ass.Equal(t, 0, dictionary.GetValue("missing"))
+
+	// Floor and ceiling lookups.
+	ass.Equal(t, "foo", dictionary.GetFloor("foo").GetKey())
+	ass.Equal(t, "foo", dictionary.GetCeiling("foo").GetKey())
+	ass.Equal(t, "baz", dictionary.GetFloor("cat").GetKey())
+	ass.Equal(t, "foo", dictionary.GetCeiling("cat").GetKey())
+	ass.Nil(t, dictionary.GetFloor("abc"))
+	ass.Equal(t, "bar", dictionary.GetCeiling("abc").GetKey())
+	ass.Equal(t, "qux", dictionary.GetFloor("zed").GetKey())
+	ass.Nil(t, dictionary.GetCeiling("zed"))
+
+	// Ranges are inclusive and independent of the original dictionary.
+	var range_ = dictionary.GetRange("baz", "foo")
+	ass.Equal(t, "[baz: 4, foo: 10]", fmt.Sprintf("%v", range_))
+	range_.SetValue("baz", 40)
+	ass.Equal(t, 4, dictionary.GetValue("baz"))
+	ass.Equal(t, "[bar: 2, baz: 4]", fmt.Sprintf("%v", dictionary.GetRange("abc", "cat")))
+	ass.True(t, dictionary.GetRange("foo", "bar").IsEmpty())
+	ass.True(t, dictionary.GetRange("r", "s").IsEmpty())
+
+	ass.Equal(t, 2, dictionary.RemoveValue("bar"))
+	ass.Equal(t, 0, dictionary.RemoveValue("bar"))
+	ass.Equal(t, "baz", dictionary.GetFirst().GetKey())
+	ass.Equal(t, []int{3, 0}, dictionary.GetValues(fra.ListFromArray([]string{"qux", "bar"})).AsArray())
+	ass.Equal(t, []int{4, 10, 3}, dictionary.GetValues(dictionary.GetKeys()).AsArray())
+
+	var bytes, _ = jsn.Marshal(dictionary)
+	ass.Equal(t, `{"baz":4,"foo":10,"qux":3}`, string(bytes))
+	var copy_ = fra.Dictionary[string, int]()
+	ass.Nil(t, jsn.Unmarshal(bytes, copy_))
+	ass.Equal(t, fmt.Sprintf("%v", dictionary), fmt.Sprintf("%v", copy_))
+	var codec = fra.Codec()
+	var decoded, _ = codec.Decode(codec.Encode(dictionary))
+	ass.Equal(t, "[baz: 4, foo: 10, qux: 3]", fmt.Sprintf("%v", decoded))
+
+	var class = fra.DictionaryClass[string, int]()
+	var other = fra.DictionaryFromArray([]fra.AssociationLike[string, int]{
+		fra.Association("alpha", 1),
+		fra.Association("foo", 100),
+	})
+	ass.Equal(t, "[alpha: 1, baz: 4, foo: 100, qux: 3]", fmt.Sprintf("%v", class.Merge(dictionary, other)))
+	ass.Equal(t, `["a": 1, "b": 2]`, fmt.Sprintf("%v", fra.DictionaryFromString[any, any](`["b": 2, "a": 1]`)))
+	dictionary.RemoveValues(fra.ListFromArray([]string{"baz", "qux"}))
+	ass.Equal(t, "[foo: 10]", fmt.Sprintf("%v", dictionary))
+	dictionary.RemoveAll()
+	ass.True(t, dictionary.IsEmpty())
+
+	// Keys that cannot be compared using "==" are ordered by the collator.
+	var versions = fra.Dictionary[fra.VersionLike, string]()
+	versions.SetValue(fra.VersionFromString("v2"), "latest")
+	versions.SetValue(fra.VersionFromString("v1.2"), "stable")
+	versions.SetValue(fra.VersionFromString("v1"), "initial")
+	ass.Equal(t, "initial", versions.GetFirst().GetValue())
+	ass.Equal(t, "latest", versions.GetLast().GetValue())
+	ass.Equal(t, "stable", versions.GetValue(fra.VersionFromString("v1.2")))
+	ass.Equal(t, "stable", versions.GetFloor(fra.VersionFromString("v1.5")).GetValue())
+	ass.Equal(t, "latest", versions.GetCeiling(fra.VersionFromString("v1.5")).GetValue())
+	versions.SetValue(fra.VersionFromString("v1.2"), "deprecated")
+	ass.Equal(t, uint(3), versions.GetSize())
+	ass.Equal(t, "deprecated", versions.GetValue(fra.VersionFromString("v1.2")))
+
+	// Keys need not be comparable at all.
+	var sequences = fra.Dictionary[[]int, string]()
+	sequences.SetValue([]int{1, 2}, "short")
+	sequences.SetValue([]int{1, 2, 3}, "long")
+	sequences.SetValue([]int{0, 9}, "first")
+	ass.Equal(t, [][]int{{0, 9}, {1, 2}, {1, 2, 3}}, sequences.GetKeys().AsArray())
+	ass.Equal(t, "long", sequences.GetValue([]int{1, 2, 3}))
+	ass.Equal(t, "short", sequences.RemoveValue([]int{1, 2}))
+	ass.Equal(t, uint(2), sequences.GetSize())
+}
+
+func TestSetRanges(t *tes.T) {
+	var integers = fra.SetFromArray([]fra.DurationLike{
+		fra.Duration(1), fra.Duration(3), fra.Duration(5), fra.Duration(7), fra.Duration(9),
+	})
+	var intrinsics = func(values fra.Sequential[fra.DurationLike]) []int {
+		var result = []int{}
+		for _, value := range values.AsArray() {
+			result = append(result, value.AsIntrinsic())
+		}
+		return result
+	}
+	var trees = fra.TreeSet[fra.DurationLike]()
+	trees.AddValues(integers)
+	var persistent = fra.PersistentSetFromSequence[fra.DurationLike](integers)
+	var sets = []fra.Ranged[fra.DurationLike]{integers, trees, persistent}
+	var tests = []struct {
+		left     fra.Bracket
+		minimum  int
+		maximum  int
+		right    fra.Bracket
+		expected []int
+	}{
+		{fra.Inclusive, 3, 7, fra.Inclusive, []int{3, 5, 7}},
+		{fra.Exclusive, 3, 7, fra.Inclusive, []int{5, 7}},
+		{fra.Inclusive, 3, 7, fra.Exclusive, []int{3, 5}},
+		{fra.Exclusive, 3, 7, fra.Exclusive, []int{5}},
+		{fra.Inclusive, 2, 8, fra.Inclusive, []int{3, 5, 7}},
+		{fra.Exclusive, 2, 8, fra.Exclusive, []int{3, 5, 7}},
+		{fra.Inclusive, -5, 20, fra.Inclusive, []int{1, 3, 5, 7, 9}},
+		{fra.Exclusive, 1, 9, fra.Exclusive, []int{3, 5, 7}},
+		{fra.Inclusive, 10, 20, fra.Inclusive, []int{}},
+		{fra.Exclusive, 5, 6, fra.Inclusive, []int{}},
+	}
+	for _, test := range tests {
+		var bounds = fra.Interval[fra.DurationLike](
+			test.left,
+			fra.Duration(test.minimum),
+			fra.Duration(test.maximum),
+			test.right,
+		)
+		for _, set := range sets {
+			var values = set.GetValuesWithin(bounds)
+			ass.Equal(t, test.expected, intrinsics(values), bounds)
+		}
+	}
+
+	// An undefined endpoint leaves that end of the bounds open.
+	var numbers = fra.TreeSet[fra.NumberLike]()
+	for _, number := range []complex128{2.5, 1, 0.5, 4, 3, 10} {
+		numbers.AddValue(fra.Number(number))
+	}
+	var continuum = fra.Continuum[fra.NumberLike](
+		fra.Exclusive,
+		fra.NumberClass().Undefined(),
+		fra.Number(3),
+		fra.Exclusive,
+	)
+	ass.Equal(t, uint(3), numbers.GetValuesWithin(continuum).GetSize())
+	continuum = fra.Continuum[fra.NumberLike](
+		fra.Inclusive,
+		fra.Number(3),
+		fra.NumberClass().Undefined(),
+		fra.Exclusive,
+	)
+	ass.Equal(t, uint(3), numbers.GetValuesWithin(continuum).GetSize())
+
+	var names = fra.SetFromArray([]fra.NameLike{
+		fra.NameFromString("/nebula/classes/abstract"),
+		fra.NameFromString("/nebula/classes/concrete"),
+		fra.NameFromString("/nebula/types/string"),
+		fra.NameFromString("/nebula/values"),
+	})
+	var spectrum = fra.Spectrum[fra.NameLike](
+		fra.Exclusive,
+		fra.NameFromString("/nebula/classes/abstract"),
+		fra.NameFromString("/nebula/values"),
+		fra.Exclusive,
+	)
+	ass.Equal(t, uint(2), names.GetValuesWithin(spectrum).GetSize())
+
+	// A few values may be retrieved from a large tree set.
+	var large = fra.TreeSet[fra.DurationLike]()
+	for value := 0; value < 10000; value++ {
+		large.AddValue(fra.Duration(value))
+	}
+	var interval = fra.Interval[fra.DurationLike](
+		fra.Inclusive,
+		fra.Duration(5000),
+		fra.Duration(5004),
+		fra.Exclusive,
+	)
+	var values = large.GetValuesWithin(interval)
+	ass.Equal(t, []int{5000, 5001, 5002, 5003}, intrinsics(values))
+}
+
+func TestBags(t *tes.T) {
+	var bag = fra.Bag[string]()
+	ass.True(t, bag.IsEmpty())
+	ass.Equal(t, "[]", fmt.Sprintf("%v", bag))
+	bag.AddValues(fra.ListFromArray([]string{"foo", "bar", "foo", "baz", "foo", "bar"}))
+	ass.Equal(t, uint(6), bag.GetSize())
+	ass.Equal(t, "[bar, bar, baz, foo, foo, foo]", fmt.Sprintf("%v", bag))
+	ass.Equal(t, []string{"bar", "baz", "foo"}, bag.GetDistinct().AsArray())
+	ass.Equal(t, uint(3), bag.GetCount("foo"))
+	ass.Equal(t, uint(2), bag.GetCount("bar"))
+	ass.Equal(t, uint(0), bag.GetCount("qux"))
+	ass.True(t, bag.ContainsValue("baz"))
+	ass.False(t, bag.ContainsValue("qux"))
+	ass.True(t, bag.ContainsAll(fra.ListFromArray([]string{"bar", "foo"})))
+	ass.True(t, bag.ContainsAny(fra.ListFromArray([]string{"qux", "foo"})))
+	ass.False(t, bag.ContainsAny(fra.ListFromArray([]string{"qux"})))
+
+	bag.RemoveValue("foo")
+	bag.RemoveValue("baz")
+	bag.RemoveValue("qux")
+	ass.Equal(t, "[bar, bar, foo, foo]", fmt.Sprintf("%v", bag))
+	bag.SetCount("qux", 2)
+	bag.SetCount("bar", 0)
+	bag.SetCount("foo", 1)
+	ass.Equal(t, "[foo, qux, qux]", fmt.Sprintf("%v", bag))
+	ass.Equal(t, uint(3), bag.GetSize())
+
+	var bytes, _ = jsn.Marshal(bag)
+	ass.Equal(t, `["foo","qux","qux"]`, string(bytes))
+	var copy_ = fra.Bag[string]()
+	ass.Nil(t, jsn.Unmarshal(bytes, copy_))
+	ass.Equal(t, bag.AsArray(), copy_.AsArray())
+	var codec = fra.Codec()
+	var decoded, _ = codec.Decode(codec.Encode(bag))
+	ass.Equal(t, "[foo, qux, qux]", fmt.Sprintf("%v", decoded))
+	ass.Equal(t, "[1, 1, 2]", fmt.Sprintf("%v", fra.BagFromString[any]("[2, 1, 1]")))
+
+	var class = fra.BagClass[string]()
+	var first = fra.BagFromArray([]string{"a", "a", "a", "b", "c"})
+	var second = fra.BagFromArray([]string{"a", "b", "b", "d"})
+	ass.Equal(t, "[a, b]", fmt.Sprintf("%v", class.And(first, second)))
+	ass.Equal(t, "[a, a, a, b, b, c, d]", fmt.Sprintf("%v", class.Ior(first, second)))
+	ass.Equal(t, "[a, a, c]", fmt.Sprintf("%v", class.San(first, second)))
+	ass.Equal(t, "[a, a, a, a, b, b, b, c, d]", fmt.Sprintf("%v", class.Sum(first, second)))
+	first.RemoveAll()
+	ass.True(t, first.IsEmpty())
+	ass.Equal(t, uint(0), first.GetSize())
+}
+
+func TestMultiCatalogs(t *tes.T) {
+	var multiCatalog = fra.MultiCatalog[string, int]()
+	ass.True(t, multiCatalog.IsEmpty())
+	ass.Equal(t, "[:]", fmt.Sprintf("%v", multiCatalog))
+	multiCatalog.AddMapping("foo", 1)
+	multiCatalog.AddMapping("bar", 2)
+	multiCatalog.AddMapping("foo", 3)
+	multiCatalog.AddMapping("foo", 1)
+	ass.Equal(t, uint(2), multiCatalog.GetSize())
+	ass.Equal(t, uint(4), multiCatalog.GetMappingCount())
+	ass.Equal(t, "[foo: [1, 3, 1], bar: [2]]", fmt.Sprintf("%v", multiCatalog))
+	ass.Equal(t, []int{1, 3, 1}, multiCatalog.GetValue("foo").AsArray())
+	ass.Nil(t, multiCatalog.GetValue("baz"))
+	ass.Equal(t, []string{"foo", "bar"}, multiCatalog.GetKeys().AsArray())
+	ass.True(t, multiCatalog.ContainsMapping("foo", 3))
+	ass.False(t, multiCatalog.ContainsMapping("bar", 3))
+	ass.False(t, multiCatalog.ContainsMapping("baz", 3))
+
+	// Only the first occurrence of a mapping is removed.
+	multiCatalog.RemoveMapping("foo", 1)
+	ass.Equal(t, []int{3, 1}, multiCatalog.GetValue("foo").AsArray())
+	multiCatalog.RemoveMapping("bar", 2)
+	multiCatalog.RemoveMapping("baz", 2)
+	ass.Equal(t, "[foo: [3, 1]]", fmt.Sprintf("%v", multiCatalog))
+
+	multiCatalog.SetValue("baz", fra.ListFromArray([]int{5, 4}))
+	multiCatalog.SetValue("qux", fra.List[int]())
+	ass.Equal(t, "[foo: [3, 1], baz: [5, 4]]", fmt.Sprintf("%v", multiCatalog))
+	multiCatalog.SortValues()
+	ass.Equal(t, "[baz: [5, 4], foo: [3, 1]]", fmt.Sprintf("%v", multiCatalog))
+
+	var bytes, _ = jsn.Marshal(multiCatalog)
+	ass.Equal(t, `{"baz":[5,4],"foo":[3,1]}`, string(bytes))
+	var copy_ = fra.MultiCatalog[string, int]()
+	ass.Nil(t, jsn.Unmarshal(bytes, copy_))
+	ass.Equal(t, "[baz: [5, 4], foo: [3, 1]]", fmt.Sprintf("%v", copy_))
+	var codec = fra.Codec()
+	var decoded, _ = codec.Decode(codec.Encode(multiCatalog))
+	ass.Equal(t, "[baz: [5, 4], foo: [3, 1]]", fmt.Sprintf("%v", decoded))
+
+	var class = fra.MultiCatalogClass[string, int]()
+	var other = fra.MultiCatalogFromArray([]fra.AssociationLike[string, int]{
+		fra.Association("foo", 7),
+		fra.Association("alpha", 8),
+		fra.Association("foo", 9),
+	})
+	ass.Equal(t, "[baz: [5, 4], foo: [3, 1, 7, 9], alpha: [8]]", fmt.Sprintf("%v", class.Merge(multiCatalog, other)))
+	ass.Equal(t, `["a": [1, 2], "b": [3]]`, fmt.Sprintf("%v", fra.MultiCatalogFromString[any, any](`["a": [1, 2], "b": [3]]`)))
+	ass.Equal(t, []int{5, 4}, multiCatalog.RemoveValue("baz").AsArray())
+	multiCatalog.RemoveAll()
+	ass.True(t, multiCatalog.IsEmpty())
+}
+
+type task struct {
+	Name     string
+	Priority int
+}
+
+func TestPriorityQueues(t *tes.T) {
+	var queue = fra.PriorityQueueFromArray([]int{5, 3, 8, 1, 9, 2})
+	ass.Equal(t, uint(6), queue.GetSize())
+	ass.Equal(t, uint(0), queue.GetCapacity())
+	ass.Equal(t, "[1, 2, 3, 5, 8, 9]", fmt.Sprintf("%v", queue))
+	var first, ok = queue.GetFirst()
+	ass.True(t, ok)
+	ass.Equal(t, 1, first)
+	first, _ = queue.RemoveFirst()
+	ass.Equal(t, 1, first)
+	first, _ = queue.RemoveFirst()
+	ass.Equal(t, 2, first)
+	ass.True(t, queue.UpdateValue(9, 0))
+	ass.False(t, queue.UpdateValue(42, 0))
+	ass.Equal(t, "[0, 3, 5, 8]", fmt.Sprintf("%v", queue))
+	var bytes, _ = jsn.Marshal(queue)
+	ass.Equal(t, "[0,3,5,8]", string(bytes))
+	var copy_ = fra.PriorityQueue[int]()
+	ass.Nil(t, jsn.Unmarshal([]byte("[7,4,6]"), copy_))
+	ass.Equal(t, []int{4, 6, 7}, copy_.AsArray())
+	queue.RemoveAll()
+	ass.True(t, queue.IsEmpty())
+	_, ok = queue.RemoveFirst()
+	ass.False(t, ok)
+	_, ok = queue.GetFirst()
+	ass.False(t, ok)
+
+	// Values with equal ranks are removed in the order in which they were added.
+	var ranker = func(first, second task) fra.Rank {
+		var collator = fra.Collator[int]()
+		return collator.RankValues(first.Priority, second.Priority)
+	}
+	var tasks = fra.PriorityQueueWithRanker(ranker)
+	for index, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		tasks.AddValue(task{name, index % 2})
+	}
+	var names []string
+	for !tasks.IsEmpty() {
+		var next, _ = tasks.RemoveFirst()
+		names = append(names, next.Name)
+	}
+	ass.Equal(t, []string{"a", "c", "e", "g", "b", "d", "f", "h"}, names)
+
+	// Decreasing the rank of a value moves it ahead of the others.
+	for index, name := range []string{"a", "b", "c"} {
+		tasks.AddValue(task{name, 10 + index})
+	}
+	ass.True(t, tasks.UpdateValue(task{"c", 12}, task{"c", 1}))
+	var next, _ = tasks.GetFirst()
+	ass.Equal(t, "c", next.Name)
+	ass.True(t, tasks.UpdateValue(task{"c", 1}, task{"c", 20}))
+	next, _ = tasks.GetFirst()
+	ass.Equal(t, "a", next.Name)
+
+	// A blocking priority queue is synchronized.
+	var group = new(syn.WaitGroup)
+	var blocking = fra.BlockingPriorityQueue(fra.Collator[int]().RankValues, 4)
+	ass.Equal(t, uint(4), blocking.GetCapacity())
+	group.Go(func() {
+		for value := 100; value > 0; value-- {
+			blocking.AddValue(value) // Will block when full.
+		}
+		blocking.CloseChannel()
+	})
+	var count int
+	for {
+		var _, ok = blocking.RemoveFirst() // Will block when empty.
+		if !ok {
+			break
+		}
+		count++
+	}
+	group.Wait()
+	ass.Equal(t, 100, count)
+}
+
+func TestBlockingPriorityQueueRemoveAll(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group = new(syn.WaitGroup)
+	defer group.Wait()
+
+	// Fill up a priority queue and then add more values in the background.
+	var queue = fra.BlockingPriorityQueue(fra.Collator[int]().RankValues, 2)
+	queue.AddValue(2)
+	queue.AddValue(1)
+	for value := 3; value < 6; value++ {
+		group.Go(func() {
+			queue.AddValue(value) // Will block until there is room.
+		})
+	}
+	tim.Sleep(10 * tim.Millisecond)
+	ass.Equal(t, []int{1, 2}, queue.AsArray())
+
+	// Removing all values wakes up the blocked producers but only two fit.
+	queue.RemoveAll()
+	tim.Sleep(10 * tim.Millisecond)
+	ass.Equal(t, uint(2), queue.GetSize())
+	var sum int
+	for range 3 {
+		var value, _ = queue.RemoveFirst()
+		sum += value
+	}
+	ass.Equal(t, 12, sum)
+	ass.True(t, queue.IsEmpty())
+}
+
+func TestBlockingPriorityQueueCloseChannel(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group = new(syn.WaitGroup)
+
+	// Block a consumer on an empty priority queue.
+	var queue = fra.BlockingPriorityQueue(fra.Collator[int]().RankValues, 1)
+	var removed = make(chan bool, 1)
+	group.Go(func() {
+		var _, ok = queue.RemoveFirst() // Will block until the queue is closed.
+		removed <- ok
+	})
+	tim.Sleep(10 * tim.Millisecond)
+
+	// Closing the priority queue wakes up the blocked consumer.
+	queue.CloseChannel()
+	group.Wait()
+	ass.False(t, <-removed)
+
+	// The priority queue is not reopened by removing all of its values.
+	queue.RemoveAll()
+	var _, ok = queue.RemoveFirst()
+	ass.False(t, ok)
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "A value cannot be added to a closed priority queue.", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	queue.AddValue(1) // Should panic here.
+}
+
+func TestBlockingPriorityQueueBlockedProducer(t *tes.T) {
+	// Block a producer on a full priority queue.
+	var queue = fra.BlockingPriorityQueue(fra.Collator[int]().RankValues, 1)
+	queue.AddValue(1)
+	var producer = new(syn.WaitGroup)
+	var message any
+	producer.Go(func() {
+		defer func() {
+			message = recover()
+		}()
+		queue.AddValue(2) // Will block until the queue is closed.
+	})
+	tim.Sleep(10 * tim.Millisecond)
+
+	// Closing the priority queue wakes up the blocked producer.
+	queue.CloseChannel()
+	producer.Wait()
+	ass.Equal(t, "A value cannot be added to a closed priority queue.", message)
+	var value, ok = queue.RemoveFirst()
+	ass.True(t, ok)
+	ass.Equal(t, 1, value)
+	_, ok = queue.RemoveFirst()
+	ass.False(t, ok)
+}
+
+func TestDeques(t *tes.T) {
+	var deque = fra.Deque[int]()
+	ass.True(t, deque.IsEmpty())
+	ass.Equal(t, uint(0), deque.GetCapacity())
+	var _, ok = deque.GetFirst()
+	ass.False(t, ok)
+	_, ok = deque.RemoveLast()
+	ass.False(t, ok)
+	for value := 1; value <= 10; value++ {
+		deque.AddLast(value)
+		deque.AddFirst(-value)
+	}
+	ass.Equal(t, uint(20), deque.GetSize())
+	var first, _ = deque.GetFirst()
+	var last, _ = deque.GetLast()
+	ass.Equal(t, -10, first)
+	ass.Equal(t, 10, last)
+	ass.Equal(t, []int{-10, -9, -8, -7, -6, -5, -4, -3, -2, -1, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, deque.AsArray())
+	for value := 10; value > 5; value-- {
+		first, _ = deque.RemoveFirst()
+		last, _ = deque.RemoveLast()
+		ass.Equal(t, -value, first)
+		ass.Equal(t, value, last)
+	}
+	deque.AddValue(6)
+	ass.Equal(t, "[-5, -4, -3, -2, -1, 1, 2, 3, 4, 5, 6]", fmt.Sprintf("%v", deque))
+	var bytes, _ = jsn.Marshal(deque)
+	ass.Equal(t, "[-5,-4,-3,-2,-1,1,2,3,4,5,6]", string(bytes))
+	var copy_ = fra.Deque[int]()
+	ass.Nil(t, jsn.Unmarshal(bytes, copy_))
+	copy_.AddFirst(-6)
+	ass.Equal(t, uint(12), copy_.GetSize())
+	first, _ = copy_.GetFirst()
+	ass.Equal(t, -6, first)
+	var codec = fra.Codec()
+	var decoded, _ = codec.Decode(codec.Encode(deque))
+	ass.Equal(t, "[-5, -4, -3, -2, -1, 1, 2, 3, 4, 5, 6]", fmt.Sprintf("%v", decoded))
+	ass.Equal(t, "[1, 2, 3]", fmt.Sprintf("%v", fra.DequeFromString[any]("[1, 2, 3]")))
+	deque.RemoveAll()
+	ass.True(t, deque.IsEmpty())
+
+	// A blocking deque is synchronized.
+	var group = new(syn.WaitGroup)
+	var blocking = fra.BlockingDeque[int](0)
+	ass.Equal(t, uint(16), blocking.GetCapacity())
+	group.Go(func() {
+		for value := 1; value <= 100; value++ {
+			if value%2 == 0 {
+				blocking.AddFirst(value) // Will block when full.
+			} else {
+				blocking.AddLast(value) // Will block when full.
+			}
+		}
+		blocking.CloseChannel()
+	})
+	var sum int
+	for {
+		var value, ok = blocking.RemoveLast() // Will block when empty.
+		if !ok {
+			break
+		}
+		sum += value
+	}
+	group.Wait()
+	ass.Equal(t, 5050, sum)
+}
+
+func TestBlockingDequeRemoveAll(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group = new(syn.WaitGroup)
+	defer group.Wait()
+
+	// Fill up a deque and then add more values in the background.
+	var deque = fra.BlockingDeque[int](2)
+	deque.AddLast(1)
+	deque.AddLast(2)
+	for value := 3; value < 6; value++ {
+		group.Go(func() {
+			deque.AddFirst(value) // Will block until there is room.
+		})
+	}
+	tim.Sleep(10 * tim.Millisecond)
+	ass.Equal(t, []int{1, 2}, deque.AsArray())
+
+	// Removing all values wakes up the blocked producers but only two fit.
+	deque.RemoveAll()
+	tim.Sleep(10 * tim.Millisecond)
+	ass.Equal(t, uint(2), deque.GetSize())
+	var sum int
+	for range 3 {
+		var value, _ = deque.RemoveLast()
+		sum += value
+	}
+	ass.Equal(t, 12, sum)
+	ass.True(t, deque.IsEmpty())
+}
+
+func TestBlockingDequeCloseChannel(t *tes.T) {
+	// Create a wait group for synchronization.
+	var group = new(syn.WaitGroup)
+
+	// Block some consumers on an empty deque.
+	var deque = fra.BlockingDeque[int](1)
+	var removed = make(chan bool, 2)
+	group.Go(func() {
+		var _, ok = deque.RemoveFirst() // Will block until the deque is closed.
+		removed <- ok
+	})
+	group.Go(func() {
+		var _, ok = deque.RemoveLast() // Will block until the deque is closed.
+		removed <- ok
+	})
+	tim.Sleep(10 * tim.Millisecond)
+
+	// Closing the deque wakes up the blocked consumers.
+	deque.CloseChannel()
+	group.Wait()
+	ass.False(t, <-removed)
+	ass.False(t, <-removed)
+
+	// The deque is not reopened by removing all of its values.
+	deque.RemoveAll()
+	var _, ok = deque.RemoveLast()
+	ass.False(t, ok)
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "A value cannot be added to a closed deque.", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	deque.AddValue(1) // Should panic here.
+}
+
+func TestBlockingDequeBlockedProducer(t *tes.T) {
+	// Block a producer on a full deque.
+	var deque = fra.BlockingDeque[int](1)
+	deque.AddLast(1)
+	var producer = new(syn.WaitGroup)
+	var message any
+	producer.Go(func() {
+		defer func() {
+			message = recover()
+		}()
+		deque.AddFirst(2) // Will block until the deque is closed.
+	})
+	tim.Sleep(10 * tim.Millisecond)
+
+	// Closing the deque wakes up the blocked producer.
+	deque.CloseChannel()
+	producer.Wait()
+	ass.Equal(t, "A value cannot be added to a closed deque.", message)
+	var value, ok = deque.RemoveFirst()
+	ass.True(t, ok)
+	ass.Equal(t, 1, value)
+	_, ok = deque.RemoveFirst()
+	ass.False(t, ok)
+}
+
+func TestGraphs(t *tes.T) {
+	var graph = fra.Graph[string]()
+	ass.True(t, graph.IsEmpty())
+	ass.Equal(t, "[:]", fmt.Sprintf("%v", graph))
+	graph.AddEdge("a", "c")
+	graph.AddEdge("a", "b")
+	graph.AddEdge("b", "d")
+	graph.AddEdge("c", "d")
+	graph.AddEdge("d", "e")
+	graph.AddNode("f")
+	ass.Equal(t, uint(6), graph.GetSize())
+	ass.Equal(t, uint(5), graph.GetEdgeCount())
+	ass.Equal(t, []string{"a", "c", "b", "d", "e", "f"}, graph.AsArray())
+	ass.True(t, graph.ContainsNode("f"))
+	ass.False(t, graph.ContainsNode("g"))
+	ass.True(t, graph.ContainsEdge("a", "b"))
+	ass.False(t, graph.ContainsEdge("b", "a"))
+	ass.Equal(t, "[a: [b, c], c: [d], b: [d], d: [e], e: [], f: []]", fmt.Sprintf("%v", graph))
+
+	// Examine the adjacencies of the nodes.
+	var successors []string
+	var iterator = graph.GetSuccessors("a")
+	for iterator.HasNext() {
+		successors = append(successors, iterator.GetNext())
+	}
+	ass.Equal(t, []string{"b", "c"}, successors)
+	var predecessors []string
+	iterator = graph.GetPredecessors("d")
+	for iterator.HasNext() {
+		predecessors = append(predecessors, iterator.GetNext())
+	}
+	ass.Equal(t, []string{"c", "b"}, predecessors)
+	ass.False(t, graph.GetSuccessors("g").HasNext())
+
+	// Traverse the graph.
+	var nodes []string
+	iterator = graph.BreadthFirst("a")
+	for iterator.HasNext() {
+		nodes = append(nodes, iterator.GetNext())
+	}
+	ass.Equal(t, []string{"a", "b", "c", "d", "e"}, nodes)
+	nodes = nil
+	iterator = graph.DepthFirst("a")
+	for iterator.HasNext() {
+		nodes = append(nodes, iterator.GetNext())
+	}
+	ass.Equal(t, []string{"a", "b", "d", "e", "c"}, nodes)
+	ass.False(t, graph.BreadthFirst("g").HasNext())
+	ass.Equal(t, []string{"a", "b", "d", "e"}, graph.GetShortestPath("a", "e").AsArray())
+	ass.Equal(t, []string{"a"}, graph.GetShortestPath("a", "a").AsArray())
+	ass.True(t, graph.GetShortestPath("e", "a").IsEmpty())
+	ass.True(t, graph.GetShortestPath("a", "f").IsEmpty())
+
+	// Sort the graph topologically.
+	ass.True(t, graph.FindCycle().IsEmpty())
+	var sorted, cycle = graph.SortTopologically()
+	ass.True(t, cycle.IsEmpty())
+	ass.Equal(t, []string{"a", "f", "b", "c", "d", "e"}, sorted.AsArray())
+	graph.AddEdge("e", "b")
+	ass.Equal(t, []string{"b", "d", "e", "b"}, graph.FindCycle().AsArray())
+	sorted, cycle = graph.SortTopologically()
+	ass.True(t, sorted.IsEmpty())
+	ass.Equal(t, []string{"b", "d", "e", "b"}, cycle.AsArray())
+	graph.RemoveEdge("e", "b")
+	ass.True(t, graph.FindCycle().IsEmpty())
+	graph.AddEdge("f", "f")
+	ass.Equal(t, []string{"f", "f"}, graph.FindCycle().AsArray())
+
+	// Remove nodes from the graph.
+	graph.RemoveNode("f")
+	graph.RemoveNode("d")
+	ass.Equal(t, uint(2), graph.GetEdgeCount())
+	ass.Equal(t, "[a: [b, c], c: [], b: [], e: []]", fmt.Sprintf("%v", graph))
+	ass.True(t, graph.GetShortestPath("a", "e").IsEmpty())
+
+	// Convert the graph to and from other forms.
+	var bytes, _ = jsn.Marshal(graph)
+	ass.Equal(t, `{"a":["b","c"],"c":[],"b":[],"e":[]}`, string(bytes))
+	var copy_ = fra.Graph[string]()
+	ass.Nil(t, jsn.Unmarshal(bytes, copy_))
+	ass.Equal(t, graph.AsArray(), copy_.AsArray())
+	ass.True(t, copy_.ContainsEdge("a", "c"))
+	var codec = fra.Codec()
+	var decoded, _ = codec.Decode(codec.Encode(graph))
+	ass.Equal(t, "[a: [b, c], c: [], b: [], e: []]", fmt.Sprintf("%v", decoded))
+
+	// Build a dependency graph of named components from a catalog.
+	var framework = fra.NameFromString("/bali/framework")
+	var strings = fra.NameFromString("/bali/strings")
+	var agents = fra.NameFromString("/bali/agents")
+	var utilities = fra.NameFromString("/bali/utilities")
+	var adjacencies = fra.Catalog[fra.NameLike, fra.ListLike[fra.NameLike]]()
+	adjacencies.SetValue(framework, fra.ListFromArray([]fra.NameLike{strings, agents}))
+	adjacencies.SetValue(strings, fra.ListFromArray([]fra.NameLike{agents}))
+	adjacencies.SetValue(agents, fra.ListFromArray([]fra.NameLike{utilities}))
+	var dependencies = fra.GraphFromCatalog(adjacencies)
+	ass.Equal(t, uint(4), dependencies.GetSize())
+	var components, loop = dependencies.SortTopologically()
+	ass.True(t, loop.IsEmpty())
+	ass.Equal(t, []fra.NameLike{framework, strings, agents, utilities}, components.AsArray())
+	ass.Equal(t, []fra.NameLike{framework, agents, utilities}, dependencies.GetShortestPath(framework, utilities).AsArray())
+	dependencies.AddEdge(utilities, strings)
+	components, loop = dependencies.SortTopologically()
+	ass.True(t, components.IsEmpty())
+	ass.Equal(t, []fra.NameLike{agents, utilities, strings, agents}, loop.AsArray())
+}
+
+func TestHierarchies(t *tes.T) {
+	var root = fra.Name(nil)
+	var database = fra.NameFromString("/database")
+	var host = fra.NameFromString("/database/primary/host")
+	var port = fra.NameFromString("/database/primary/port")
+	var timeout = fra.NameFromString("/database/timeout")
+	var logging = fra.NameFromString("/logging/level")
+	var hierarchy = fra.Hierarchy[string]()
+	ass.True(t, hierarchy.IsEmpty())
+	ass.Equal(t, "[:]", fmt.Sprintf("%v", hierarchy))
+	hierarchy.SetValue(host, "localhost")
+	hierarchy.SetValue(port, "5432")
+	hierarchy.SetValue(logging, "debug")
+	hierarchy.SetValue(timeout, "30s")
+	hierarchy.SetValue(database, "postgres")
+	ass.Equal(t, uint(5), hierarchy.GetSize())
+	ass.Equal(t, "localhost", hierarchy.GetValue(host))
+	ass.Equal(t, "", hierarchy.GetValue(fra.NameFromString("/database/primary")))
+	ass.True(t, hierarchy.ContainsPath(database))
+	ass.False(t, hierarchy.ContainsPath(fra.NameFromString("/database/primary")))
+	ass.Equal(t, "[/database: postgres, /database/primary/host: localhost, /database/primary/port: 5432, /database/timeout: 30s, /logging/level: debug]", fmt.Sprintf("%v", hierarchy))
+
+	// Examine the structure of the hierarchy.
+	ass.Equal(t, "[/database, /logging]", fmt.Sprintf("%v", hierarchy.GetChildren(root)))
+	ass.Equal(t, "[/database/primary, /database/timeout]", fmt.Sprintf("%v", hierarchy.GetChildren(database)))
+	ass.True(t, hierarchy.GetChildren(host).IsEmpty())
+	ass.True(t, hierarchy.GetChildren(fra.NameFromString("/missing")).IsEmpty())
+	var paths []string
+	var iterator = hierarchy.WalkPrefix(fra.NameFromString("/database/primary"))
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		paths = append(paths, association.GetKey().AsString()+"="+association.GetValue())
+	}
+	ass.Equal(t, []string{"/database/primary/host=localhost", "/database/primary/port=5432"}, paths)
+	ass.False(t, hierarchy.WalkPrefix(fra.NameFromString("/missing")).HasNext())
+	ass.Equal(t, uint(5), uint(len(hierarchy.AsMap())))
+
+	// Extract and remove subtrees.
+	var subtree = hierarchy.GetSubtree(database)
+	ass.Equal(t, uint(4), subtree.GetSize())
+	ass.Equal(t, "postgres", subtree.GetValue(database))
+	ass.False(t, subtree.ContainsPath(logging))
+	subtree.SetValue(timeout, "60s")
+	ass.Equal(t, "30s", hierarchy.GetValue(timeout))
+	var removed = hierarchy.RemoveSubtree(fra.NameFromString("/database/primary"))
+	ass.Equal(t, uint(2), removed.GetSize())
+	ass.Equal(t, uint(3), hierarchy.GetSize())
+	ass.Equal(t, "[/database/timeout]", fmt.Sprintf("%v", hierarchy.GetChildren(database)))
+	ass.True(t, hierarchy.RemoveSubtree(fra.NameFromString("/missing")).IsEmpty())
+
+	// Move subtrees within the hierarchy.
+	ass.True(t, hierarchy.MoveSubtree(database, fra.NameFromString("/services/storage")))
+	ass.Equal(t, "[/logging/level: debug, /services/storage: postgres, /services/storage/timeout: 30s]", fmt.Sprintf("%v", hierarchy))
+	ass.Equal(t, "[/logging, /services]", fmt.Sprintf("%v", hierarchy.GetChildren(root)))
+	ass.False(t, hierarchy.MoveSubtree(database, fra.NameFromString("/other")))
+	ass.False(t, hierarchy.MoveSubtree(fra.NameFromString("/services"), fra.NameFromString("/services/storage/nested")))
+	ass.False(t, hierarchy.MoveSubtree(fra.NameFromString("/logging"), fra.NameFromString("/services")))
+	ass.False(t, hierarchy.MoveSubtree(root, fra.NameFromString("/other")))
+	ass.Equal(t, uint(3), hierarchy.GetSize())
+
+	// Remove values, pruning the nodes that no longer lead to a value.
+	ass.Equal(t, "debug", hierarchy.RemoveValue(logging))
+	ass.Equal(t, "", hierarchy.RemoveValue(logging))
+	ass.Equal(t, "[/services]", fmt.Sprintf("%v", hierarchy.GetChildren(root)))
+	var keys = fra.ListFromArray([]fra.NameLike{fra.NameFromString("/services/storage")})
+	ass.Equal(t, []string{"postgres"}, hierarchy.RemoveValues(keys).AsArray())
+	ass.Equal(t, "[/services/storage/timeout: 30s]", fmt.Sprintf("%v", hierarchy))
+	ass.Equal(t, "[/services/storage]", fmt.Sprintf("%v", hierarchy.GetChildren(fra.NameFromString("/services"))))
+
+	// Convert the hierarchy to and from other forms.
+	var bytes, _ = jsn.Marshal(subtree)
+	ass.Equal(t, `{"/database":"postgres","/database/primary/host":"localhost","/database/primary/port":"5432","/database/timeout":"60s"}`, string(bytes))
+	var copy_ = fra.Hierarchy[string]()
+	ass.Nil(t, jsn.Unmarshal(bytes, copy_))
+	ass.Equal(t, subtree.GetKeys().AsArray(), copy_.GetKeys().AsArray())
+	ass.Equal(t, "localhost", copy_.GetValue(host))
+	var codec = fra.Codec()
+	var decoded, _ = codec.Decode(codec.Encode(subtree))
+	ass.Equal(t, fmt.Sprintf("%v", subtree), fmt.Sprintf("%v", decoded))
+	var parsed = fra.HierarchyFromString[any](`[/a/b: 1, /a: "x", /c: [1, 2]]`)
+	ass.Equal(t, `[/a: "x", /a/b: 1, /c: [1, 2]]`, fmt.Sprintf("%v", parsed))
+	var sequence = fra.HierarchyFromSequence(subtree)
+	ass.Equal(t, subtree.AsArray(), sequence.AsArray())
+	hierarchy.RemoveAll()
+	ass.True(t, hierarchy.IsEmpty())
+	ass.True(t, hierarchy.GetChildren(root).IsEmpty())
+}
+
+func TestHierarchyRootValue(t *tes.T) {
+	var hierarchy = fra.Hierarchy[string]()
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, "A value cannot be stored at the root of a hierarchy.", e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	hierarchy.SetValue(fra.Name(nil), "illegal")
+}
+
+func TestHierarchyIllegalString(t *tes.T) {
+	defer func() {
+		if e := recover(); e != nil {
+			ass.Equal(t, `An illegal string was passed to the hierarchy constructor method: ["a": 1]`, e)
+		} else {
+			ass.Fail(t, "Test should result in recovered panic.")
+		}
+	}()
+	fra.HierarchyFromString[any](`["a": 1]`)
+}
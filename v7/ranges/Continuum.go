@@ -13,11 +13,14 @@
 package ranges
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	ele "github.com/craterdog/go-component-framework/v7/elements"
 	str "github.com/craterdog/go-component-framework/v7/strings"
+	sts "strings"
 	syn "sync"
+	utf "unicode/utf8"
 )
 
 // CLASS INTERFACE
@@ -47,6 +50,88 @@ func (c *continuumClass_[V]) Continuum(
 	return instance
 }
 
+func (c *continuumClass_[V]) ParseContinuum(
+	source string,
+) (
+	continuum ContinuumLike[V],
+	err error,
+) {
+	var parser = ele.ElementParserClass().ElementParser()
+	var position int
+
+	// Parse the left bracket.
+	var left Bracket
+	switch {
+	case sts.HasPrefix(source, "["):
+		left = Inclusive
+	case sts.HasPrefix(source, "("):
+		left = Exclusive
+	default:
+		err = c.parseError(source, position)
+		return
+	}
+	position++
+
+	// Parse the minimum endpoint.
+	var value, length = parser.ParseElement(source[position:])
+	var minimum, ok = value.(V)
+	if !ok {
+		err = c.parseError(source, position)
+		return
+	}
+	position += int(length)
+
+	// Parse the range operator.
+	if !sts.HasPrefix(source[position:], "..") {
+		err = c.parseError(source, position)
+		return
+	}
+	position += 2
+
+	// Parse the maximum endpoint.
+	var endpoint = position
+	value, length = parser.ParseElement(source[position:])
+	var maximum V
+	maximum, ok = value.(V)
+	if !ok {
+		err = c.parseError(source, position)
+		return
+	}
+	position += int(length)
+
+	// Parse the right bracket.
+	var right Bracket
+	switch {
+	case sts.HasPrefix(source[position:], "]"):
+		right = Inclusive
+	case sts.HasPrefix(source[position:], ")"):
+		right = Exclusive
+	default:
+		err = c.parseError(source, position)
+		return
+	}
+	position++
+	if position < len(source) {
+		err = c.parseError(source, position)
+		return
+	}
+
+	// Validate the endpoints.
+	var instance = &continuum_[V]{
+		// Initialize the instance attributes.
+		left_:    left,
+		minimum_: minimum,
+		maximum_: maximum,
+		right_:   right,
+	}
+	if !instance.hasValidEndpoints() {
+		err = c.parseError(source, endpoint)
+		return
+	}
+	continuum = instance
+	return
+}
+
 // Constant Methods
 
 // Function Methods
@@ -167,8 +252,56 @@ func (v *continuum_[V]) String() string {
 	return string_
 }
 
+func (v *continuum_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.String())
+}
+
+func (v *continuum_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var continuum ContinuumLike[V]
+	continuum, err = continuumClass[V]().ParseContinuum(source)
+	if err != nil {
+		return err
+	}
+	*v = *continuum.(*continuum_[V])
+	return nil
+}
+
 // Private Methods
 
+// This private method returns an error describing where the specified source
+// string failed to parse.
+func (c *continuumClass_[V]) parseError(
+	source string,
+	position int,
+) error {
+	var err = &age.ParseError{
+		Class:  "Continuum",
+		Source: source,
+		Offset: uint(utf.RuneCountInString(source[:position])),
+	}
+	return err
+}
+
+// This private method determines whether or not the endpoints are valid
+// without panicking.
+func (v *continuum_[V]) hasValidEndpoints() bool {
+	if v.minimum_.IsDefined() && v.maximum_.IsDefined() {
+		var collator = age.CollatorClass[V]().Collator()
+		if collator.RankValues(v.minimum_, v.maximum_) != age.LesserRank {
+			return false
+		}
+		return v.maximum_.AsFloat() > v.minimum_.AsFloat()
+	}
+	return true
+}
+
 // This method ensures that the endpoints are valid.
 func (v *continuum_[V]) validateContinuum() {
 	// Validate the left bracket.
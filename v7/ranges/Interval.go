@@ -13,6 +13,7 @@
 package ranges
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	ele "github.com/craterdog/go-component-framework/v7/elements"
@@ -21,6 +22,7 @@ import (
 	ref "reflect"
 	sts "strings"
 	syn "sync"
+	utf "unicode/utf8"
 )
 
 // CLASS INTERFACE
@@ -50,6 +52,88 @@ func (c *intervalClass_[V]) Interval(
 	return instance
 }
 
+func (c *intervalClass_[V]) ParseInterval(
+	source string,
+) (
+	interval IntervalLike[V],
+	err error,
+) {
+	var parser = ele.ElementParserClass().ElementParser()
+	var position int
+
+	// Parse the left bracket.
+	var left Bracket
+	switch {
+	case sts.HasPrefix(source, "["):
+		left = Inclusive
+	case sts.HasPrefix(source, "("):
+		left = Exclusive
+	default:
+		err = c.parseError(source, position)
+		return
+	}
+	position++
+
+	// Parse the minimum endpoint.
+	var value, length = parser.ParseElement(source[position:])
+	var minimum, ok = value.(V)
+	if !ok {
+		err = c.parseError(source, position)
+		return
+	}
+	position += int(length)
+
+	// Parse the range operator.
+	if !sts.HasPrefix(source[position:], "..") {
+		err = c.parseError(source, position)
+		return
+	}
+	position += 2
+
+	// Parse the maximum endpoint.
+	var endpoint = position
+	value, length = parser.ParseElement(source[position:])
+	var maximum V
+	maximum, ok = value.(V)
+	if !ok {
+		err = c.parseError(source, position)
+		return
+	}
+	position += int(length)
+
+	// Parse the right bracket.
+	var right Bracket
+	switch {
+	case sts.HasPrefix(source[position:], "]"):
+		right = Inclusive
+	case sts.HasPrefix(source[position:], ")"):
+		right = Exclusive
+	default:
+		err = c.parseError(source, position)
+		return
+	}
+	position++
+	if position < len(source) {
+		err = c.parseError(source, position)
+		return
+	}
+
+	// Validate the endpoints.
+	var instance = &interval_[V]{
+		// Initialize the instance attributes.
+		left_:    left,
+		minimum_: minimum,
+		maximum_: maximum,
+		right_:   right,
+	}
+	if !instance.hasValidEndpoints() {
+		err = c.parseError(source, endpoint)
+		return
+	}
+	interval = instance
+	return
+}
+
 // Constant Methods
 
 // Function Methods
@@ -239,8 +323,56 @@ func (v *interval_[V]) String() string {
 	return string_
 }
 
+func (v *interval_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.String())
+}
+
+func (v *interval_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var interval IntervalLike[V]
+	interval, err = intervalClass[V]().ParseInterval(source)
+	if err != nil {
+		return err
+	}
+	*v = *interval.(*interval_[V])
+	return nil
+}
+
 // Private Methods
 
+// This private method returns an error describing where the specified source
+// string failed to parse.
+func (c *intervalClass_[V]) parseError(
+	source string,
+	position int,
+) error {
+	var err = &age.ParseError{
+		Class:  "Interval",
+		Source: source,
+		Offset: uint(utf.RuneCountInString(source[:position])),
+	}
+	return err
+}
+
+// This private method determines whether or not the endpoints are valid
+// without panicking.
+func (v *interval_[V]) hasValidEndpoints() bool {
+	if v.minimum_.IsDefined() && v.maximum_.IsDefined() {
+		var collator = age.CollatorClass[V]().Collator()
+		if collator.RankValues(v.minimum_, v.maximum_) != age.LesserRank {
+			return false
+		}
+		return v.effectiveMaximum() >= v.effectiveMinimum()
+	}
+	return true
+}
+
 func (v *interval_[V]) effectiveMaximum() int {
 	var maximum = v.maximum_.AsInteger()
 	maximum -= int(v.right_)
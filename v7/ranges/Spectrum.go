@@ -13,10 +13,13 @@
 package ranges
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	str "github.com/craterdog/go-component-framework/v7/strings"
+	sts "strings"
 	syn "sync"
+	utf "unicode/utf8"
 )
 
 // CLASS INTERFACE
@@ -46,6 +49,88 @@ func (c *spectrumClass_[V]) Spectrum(
 	return instance
 }
 
+func (c *spectrumClass_[V]) ParseSpectrum(
+	source string,
+) (
+	spectrum SpectrumLike[V],
+	err error,
+) {
+	var parser = str.StringParserClass().StringParser()
+	var position int
+
+	// Parse the left bracket.
+	var left Bracket
+	switch {
+	case sts.HasPrefix(source, "["):
+		left = Inclusive
+	case sts.HasPrefix(source, "("):
+		left = Exclusive
+	default:
+		err = c.parseError(source, position)
+		return
+	}
+	position++
+
+	// Parse the minimum endpoint.
+	var value, length = parser.ParseString(source[position:])
+	var minimum, ok = value.(V)
+	if !ok {
+		err = c.parseError(source, position)
+		return
+	}
+	position += int(length)
+
+	// Parse the range operator.
+	if !sts.HasPrefix(source[position:], "..") {
+		err = c.parseError(source, position)
+		return
+	}
+	position += 2
+
+	// Parse the maximum endpoint.
+	var endpoint = position
+	value, length = parser.ParseString(source[position:])
+	var maximum V
+	maximum, ok = value.(V)
+	if !ok {
+		err = c.parseError(source, position)
+		return
+	}
+	position += int(length)
+
+	// Parse the right bracket.
+	var right Bracket
+	switch {
+	case sts.HasPrefix(source[position:], "]"):
+		right = Inclusive
+	case sts.HasPrefix(source[position:], ")"):
+		right = Exclusive
+	default:
+		err = c.parseError(source, position)
+		return
+	}
+	position++
+	if position < len(source) {
+		err = c.parseError(source, position)
+		return
+	}
+
+	// Validate the endpoints.
+	var instance = &spectrum_[V]{
+		// Initialize the instance attributes.
+		left_:    left,
+		minimum_: minimum,
+		maximum_: maximum,
+		right_:   right,
+	}
+	if !instance.hasValidEndpoints() {
+		err = c.parseError(source, endpoint)
+		return
+	}
+	spectrum = instance
+	return
+}
+
 // Constant Methods
 
 // Function Methods
@@ -162,8 +247,50 @@ func (v *spectrum_[V]) String() string {
 	return string_
 }
 
+func (v *spectrum_[V]) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.String())
+}
+
+func (v *spectrum_[V]) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var spectrum SpectrumLike[V]
+	spectrum, err = spectrumClass[V]().ParseSpectrum(source)
+	if err != nil {
+		return err
+	}
+	*v = *spectrum.(*spectrum_[V])
+	return nil
+}
+
 // Private Methods
 
+// This private method returns an error describing where the specified source
+// string failed to parse.
+func (c *spectrumClass_[V]) parseError(
+	source string,
+	position int,
+) error {
+	var err = &age.ParseError{
+		Class:  "Spectrum",
+		Source: source,
+		Offset: uint(utf.RuneCountInString(source[:position])),
+	}
+	return err
+}
+
+// This private method determines whether or not the endpoints are valid
+// without panicking.
+func (v *spectrum_[V]) hasValidEndpoints() bool {
+	var collator = age.CollatorClass[V]().Collator()
+	return collator.RankValues(v.minimum_, v.maximum_) == age.LesserRank
+}
+
 // This method ensures that the endpoints are valid.
 func (v *spectrum_[V]) validateSpectrum() {
 	// Validate the left bracket.
@@ -61,6 +61,9 @@ supported by each concrete continuum-like class.
 A continuum-like class defines two endpoints for an infinite continuous
 sequence of elements.  The endpoints may be inclusive (denoted by a square
 bracket) or exclusive (denoted by a round bracket).
+
+A continuum may be parsed from its string form (e.g. "[1..5)") as long as both
+of its endpoints are specified.
 */
 type ContinuumClassLike[V ele.Continuous] interface {
 	// Constructor Methods
@@ -70,6 +73,12 @@ type ContinuumClassLike[V ele.Continuous] interface {
 		maximum V,
 		right Bracket,
 	) ContinuumLike[V]
+	ParseContinuum(
+		source string,
+	) (
+		continuum ContinuumLike[V],
+		err error,
+	)
 }
 
 /*
@@ -80,6 +89,9 @@ supported by each concrete interval-like class.
 An interval-like class defines two endpoints for a finite discrete sequence of
 elements.  The endpoints may be inclusive (denoted by a square bracket) or
 exclusive (denoted by a round bracket).
+
+An interval may be parsed from its string form (e.g. "['a'..'z']") as long as
+both of its endpoints are specified.
 */
 type IntervalClassLike[V ele.Discrete] interface {
 	// Constructor Methods
@@ -89,6 +101,12 @@ type IntervalClassLike[V ele.Discrete] interface {
 		maximum V,
 		right Bracket,
 	) IntervalLike[V]
+	ParseInterval(
+		source string,
+	) (
+		interval IntervalLike[V],
+		err error,
+	)
 }
 
 /*
@@ -99,6 +117,9 @@ must be supported by each concrete spectrum-like class.
 A spectrum-like class defines two endpoints for an infinite discrete sequence
 of elements.  The endpoints may be inclusive (denoted by a square bracket) or
 exclusive (denoted by a round bracket).
+
+A spectrum may be parsed from its string form (e.g. "[v1..v2)") as long as both
+of its endpoints are specified.
 */
 type SpectrumClassLike[V str.Spectral[V]] interface {
 	// Constructor Methods
@@ -108,6 +129,12 @@ type SpectrumClassLike[V str.Spectral[V]] interface {
 		maximum V,
 		right Bracket,
 	) SpectrumLike[V]
+	ParseSpectrum(
+		source string,
+	) (
+		spectrum SpectrumLike[V],
+		err error,
+	)
 }
 
 // INSTANCE DECLARATIONS
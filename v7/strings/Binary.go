@@ -13,6 +13,7 @@
 package strings
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
@@ -72,6 +73,21 @@ func (c *binaryClass_) BinaryFromString(
 	return binary_(source)
 }
 
+func (c *binaryClass_) ParseBinary(
+	source string,
+) (
+	binary BinaryLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Binary", c.matcher_)
+	_, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	binary = binary_(source)
+	return
+}
+
 // Constant Methods
 
 // Function Methods
@@ -323,6 +339,27 @@ func (v binary_) String() string {
 	return v.AsString()
 }
 
+func (v binary_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *binary_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var binary BinaryLike
+	binary, err = binaryClass().ParseBinary(source)
+	if err != nil {
+		return err
+	}
+	*v = binary.(binary_)
+	return nil
+}
+
 // Private Methods
 
 // NOTE:
@@ -13,6 +13,7 @@
 package strings
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
@@ -61,6 +62,21 @@ func (c *nameClass_) NameFromString(
 	return name_(source)
 }
 
+func (c *nameClass_) ParseName(
+	source string,
+) (
+	name NameLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Name", c.matcher_)
+	_, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	name = name_(source)
+	return
+}
+
 // Constant Methods
 
 // Function Methods
@@ -220,6 +236,27 @@ func (v name_) String() string {
 	return v.AsString()
 }
 
+func (v name_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *name_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var name NameLike
+	name, err = nameClass().ParseName(source)
+	if err != nil {
+		return err
+	}
+	*v = name.(name_)
+	return nil
+}
+
 // Private Methods
 
 // NOTE:
@@ -13,6 +13,7 @@
 package strings
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
@@ -67,6 +68,21 @@ func (c *narrativeClass_) NarrativeFromString(
 	return narrative_(source)
 }
 
+func (c *narrativeClass_) ParseNarrative(
+	source string,
+) (
+	narrative NarrativeLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Narrative", c.matcher_)
+	_, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	narrative = narrative_(source)
+	return
+}
+
 // Constant Methods
 
 // Function Methods
@@ -219,6 +235,27 @@ func (v narrative_) String() string {
 	return v.AsString()
 }
 
+func (v narrative_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *narrative_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var narrative NarrativeLike
+	narrative, err = narrativeClass().ParseNarrative(source)
+	if err != nil {
+		return err
+	}
+	*v = narrative.(narrative_)
+	return nil
+}
+
 // Private Methods
 
 // NOTE:
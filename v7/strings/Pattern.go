@@ -13,6 +13,7 @@
 package strings
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
@@ -69,6 +70,21 @@ func (c *patternClass_) PatternFromString(
 	return pattern_(source)
 }
 
+func (c *patternClass_) ParsePattern(
+	source string,
+) (
+	pattern PatternLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Pattern", c.matcher_)
+	_, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	pattern = pattern_(source)
+	return
+}
+
 // Constant Methods
 
 func (c *patternClass_) None() PatternLike {
@@ -238,6 +254,27 @@ func (v pattern_) String() string {
 	return v.AsString()
 }
 
+func (v pattern_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *pattern_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var pattern PatternLike
+	pattern, err = patternClass().ParsePattern(source)
+	if err != nil {
+		return err
+	}
+	*v = pattern.(pattern_)
+	return nil
+}
+
 // Private Methods
 
 // NOTE:
@@ -248,7 +285,7 @@ func (v pattern_) String() string {
 // each name to lessen the chance of a name collision with other private Go
 // class constants in this package.
 const (
-	regex_ = "\"((?:" + character_ + ")+)\"\\\\?"
+	regex_ = "\"((?:" + character_ + ")+)\"\\?"
 )
 
 // Instance Structure
@@ -13,6 +13,7 @@
 package strings
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
@@ -57,6 +58,21 @@ func (c *quoteClass_) QuoteFromString(
 	return quote_(source)
 }
 
+func (c *quoteClass_) ParseQuote(
+	source string,
+) (
+	quote QuoteLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Quote", c.matcher_)
+	_, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	quote = quote_(source)
+	return
+}
+
 // Constant Methods
 
 // Function Methods
@@ -207,6 +223,27 @@ func (v quote_) String() string {
 	return v.AsString()
 }
 
+func (v quote_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *quote_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var quote QuoteLike
+	quote, err = quoteClass().ParseQuote(source)
+	if err != nil {
+		return err
+	}
+	*v = quote.(quote_)
+	return nil
+}
+
 // Private Methods
 
 // NOTE:
@@ -0,0 +1,175 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2025 Crater Dog Technologies™.  All Rights Reserved.   .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package strings
+
+import (
+	reg "regexp"
+)
+
+// CLASS INTERFACE
+
+// Access Function
+
+func StringParserClass() StringParserClassLike {
+	return stringParserClass()
+}
+
+// Constructor Methods
+
+func (c *stringParserClass_) StringParser() StringParserLike {
+	var instance = &stringParser_{
+		// Initialize the instance attributes.
+	}
+	return instance
+}
+
+// Constant Methods
+
+// Function Methods
+
+// INSTANCE INTERFACE
+
+// Principal Methods
+
+func (v *stringParser_) GetClass() StringParserClassLike {
+	return stringParserClass()
+}
+
+func (v *stringParser_) ParseString(
+	source string,
+) (
+	string_ any,
+	length uint,
+) {
+	// Find the longest literal that matches the start of the source.
+	var class = stringParserClass()
+	var longest *literal_
+	for _, literal := range class.literals_ {
+		var size = class.matchLength(literal.matcher_, source)
+		if size > length {
+			// Earlier literals take precedence when the lengths are the same.
+			longest = literal
+			length = size
+		}
+	}
+	if longest == nil {
+		// The source does not start with a string literal.
+		return
+	}
+
+	// Construct the string using its corresponding class.
+	var err error
+	string_, err = longest.constructor_(source[:length])
+	if err != nil {
+		// The literal is well formed but does not describe a legal string.
+		string_ = nil
+		length = 0
+	}
+	return
+}
+
+// Attribute Methods
+
+// PROTECTED INTERFACE
+
+// Private Methods
+
+// This private class method returns the number of bytes at the START of the
+// specified source that are matched by the specified matcher.  Some of the
+// matchers contain alternatives that are not anchored to the start of the
+// source so any match that does not begin at the start is ignored.
+func (c *stringParserClass_) matchLength(
+	matcher *reg.Regexp,
+	source string,
+) uint {
+	var location = matcher.FindStringIndex(source)
+	if location == nil || location[0] > 0 {
+		return 0
+	}
+	return uint(location[1])
+}
+
+// This private type pairs the matcher for a string literal with the
+// constructor for its string class.
+type literal_ struct {
+	matcher_     *reg.Regexp
+	constructor_ func(source string) (any, error)
+}
+
+// Instance Structure
+
+type stringParser_ struct {
+	// Declare the instance attributes.
+}
+
+// Class Structure
+
+type stringParserClass_ struct {
+	// Declare the class constants.
+	literals_ []*literal_
+}
+
+// Class Reference
+
+func stringParserClass() *stringParserClass_ {
+	return stringParserClassReference_
+}
+
+var stringParserClassReference_ = &stringParserClass_{
+	// Initialize the class constants.
+	literals_: []*literal_{
+		{
+			matcher_: binaryClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return binaryClassReference_.ParseBinary(source)
+			},
+		},
+		{
+			matcher_: nameClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return nameClassReference_.ParseName(source)
+			},
+		},
+		{
+			// A narrative must precede a quote since ">...<" is a legal quote.
+			matcher_: narrativeClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return narrativeClassReference_.ParseNarrative(source)
+			},
+		},
+		{
+			matcher_: patternClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return patternClassReference_.ParsePattern(source)
+			},
+		},
+		{
+			matcher_: quoteClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return quoteClassReference_.ParseQuote(source)
+			},
+		},
+		{
+			matcher_: tagClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return tagClassReference_.ParseTag(source)
+			},
+		},
+		{
+			matcher_: versionClassReference_.matcher_,
+			constructor_: func(source string) (any, error) {
+				return versionClassReference_.ParseVersion(source)
+			},
+		},
+	},
+}
@@ -14,6 +14,7 @@ package strings
 
 import (
 	bin "encoding/binary"
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
@@ -71,6 +72,21 @@ func (c *tagClass_) TagFromString(
 	return tag_(source)
 }
 
+func (c *tagClass_) ParseTag(
+	source string,
+) (
+	tag TagLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Tag", c.matcher_)
+	_, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	tag = tag_(source)
+	return
+}
+
 // Constant Methods
 
 // Function Methods
@@ -216,6 +232,27 @@ func (v tag_) String() string {
 	return v.AsString()
 }
 
+func (v tag_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *tag_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var tag TagLike
+	tag, err = tagClass().ParseTag(source)
+	if err != nil {
+		return err
+	}
+	*v = tag.(tag_)
+	return nil
+}
+
 // Private Methods
 
 func (c *tagClass_) validateSize(
@@ -13,6 +13,7 @@
 package strings
 
 import (
+	jsn "encoding/json"
 	fmt "fmt"
 	age "github.com/craterdog/go-component-framework/v7/agents"
 	uti "github.com/craterdog/go-missing-utilities/v7"
@@ -63,6 +64,21 @@ func (c *versionClass_) VersionFromString(
 	return version_(source)
 }
 
+func (c *versionClass_) ParseVersion(
+	source string,
+) (
+	version VersionLike,
+	err error,
+) {
+	var matcher = age.MatcherClass().Matcher("Version", c.matcher_)
+	_, err = matcher.MatchSource(source)
+	if err != nil {
+		return
+	}
+	version = version_(source)
+	return
+}
+
 // Constant Methods
 
 // Function Methods
@@ -282,6 +298,27 @@ func (v version_) String() string {
 	return v.AsString()
 }
 
+func (v version_) MarshalJSON() ([]byte, error) {
+	return jsn.Marshal(v.AsString())
+}
+
+func (v *version_) UnmarshalJSON(
+	bytes []byte,
+) error {
+	var source string
+	var err = jsn.Unmarshal(bytes, &source)
+	if err != nil {
+		return err
+	}
+	var version VersionLike
+	version, err = versionClass().ParseVersion(source)
+	if err != nil {
+		return err
+	}
+	*v = version.(version_)
+	return nil
+}
+
 // Private Methods
 
 // NOTE:
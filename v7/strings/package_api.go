@@ -73,6 +73,12 @@ type BinaryClassLike interface {
 	BinaryFromString(
 		source string,
 	) BinaryLike
+	ParseBinary(
+		source string,
+	) (
+		binary BinaryLike,
+		err error,
+	)
 
 	// Function Methods
 	Not(
@@ -116,6 +122,12 @@ type NameClassLike interface {
 	NameFromString(
 		source string,
 	) NameLike
+	ParseName(
+		source string,
+	) (
+		name NameLike,
+		err error,
+	)
 
 	// Function Methods
 	Concatenate(
@@ -140,6 +152,12 @@ type NarrativeClassLike interface {
 	NarrativeFromString(
 		source string,
 	) NarrativeLike
+	ParseNarrative(
+		source string,
+	) (
+		narrative NarrativeLike,
+		err error,
+	)
 
 	// Function Methods
 	Concatenate(
@@ -164,6 +182,12 @@ type PatternClassLike interface {
 	PatternFromString(
 		source string,
 	) PatternLike
+	ParsePattern(
+		source string,
+	) (
+		pattern PatternLike,
+		err error,
+	)
 
 	// Constant Methods
 	None() PatternLike
@@ -192,6 +216,12 @@ type QuoteClassLike interface {
 	QuoteFromString(
 		source string,
 	) QuoteLike
+	ParseQuote(
+		source string,
+	) (
+		quote QuoteLike,
+		err error,
+	)
 
 	// Function Methods
 	Concatenate(
@@ -200,6 +230,21 @@ type QuoteClassLike interface {
 	) QuoteLike
 }
 
+/*
+StringParserClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+string-parser-like concrete class.
+
+A string-parser-like class recognizes the string literal (e.g. "#ABC…",
+"v1.2.3", "/a/b" or "'>…<'") at the start of a source string and constructs
+the corresponding string using its class.  When more than one kind of literal
+matches the start of the source string the longest match wins.
+*/
+type StringParserClassLike interface {
+	// Constructor Methods
+	StringParser() StringParserLike
+}
+
 /*
 TagClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -219,6 +264,12 @@ type TagClassLike interface {
 	TagFromString(
 		source string,
 	) TagLike
+	ParseTag(
+		source string,
+	) (
+		tag TagLike,
+		err error,
+	)
 
 	// Function Methods
 	Concatenate(
@@ -243,6 +294,12 @@ type VersionClassLike interface {
 	VersionFromString(
 		source string,
 	) VersionLike
+	ParseVersion(
+		source string,
+	) (
+		version VersionLike,
+		err error,
+	)
 
 	// Function Methods
 	IsValidNextVersion(
@@ -355,6 +412,27 @@ type QuoteLike interface {
 	Spectral[QuoteLike]
 }
 
+/*
+StringParserLike is an instance interface that declares the complete set of
+principal, attribute and aspect methods that must be supported by each instance
+of a concrete string-parser-like class.
+
+The ParseString() method returns the string whose literal begins at the start
+of the specified source string along with the number of bytes in that literal.
+If the source string does not begin with a string literal, or the literal does
+not describe a legal string, the string is nil and the length is zero.
+*/
+type StringParserLike interface {
+	// Principal Methods
+	GetClass() StringParserClassLike
+	ParseString(
+		source string,
+	) (
+		string_ any,
+		length uint,
+	)
+}
+
 /*
 TagLike is an instance interface that declares the complete set of principal,
 attribute and aspect methods that must be supported by each instance of a